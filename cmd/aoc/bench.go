@@ -0,0 +1,192 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"text/tabwriter"
+	"time"
+
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/day/daytest"
+)
+
+// benchResult is the cost of count runs of one part of a solver.
+type benchResult struct {
+	solver day.Solver
+	part   int
+	input  string
+	runs   []day.Measurement
+}
+
+func (r benchResult) fastest() time.Duration {
+	result := r.runs[0].Wall
+	for _, m := range r.runs[1:] {
+		result = min(result, m.Wall)
+	}
+	return result
+}
+
+func (r benchResult) mean() day.Measurement {
+	var result day.Measurement
+	for _, m := range r.runs {
+		result.Wall += m.Wall
+		result.Allocs += m.Allocs
+		result.Bytes += m.Bytes
+		result.PeakHeap = max(result.PeakHeap, m.PeakHeap)
+	}
+	n := uint64(len(r.runs))
+	result.Wall /= time.Duration(n)
+	result.Allocs /= n
+	result.Bytes /= n
+	return result
+}
+
+// withVariants adds the variants of every primary implementation in solvers,
+// so that 'aoc bench 25' compares day25 with day25b.
+func withVariants(solvers []day.Solver) []day.Solver {
+	result := make([]day.Solver, 0, len(solvers))
+	seen := make(map[string]bool)
+	for _, s := range solvers {
+		variants := []day.Solver{s}
+		if !s.IsVariant() {
+			variants = day.Variants(s.Day)
+		}
+		for _, v := range variants {
+			if !seen[v.Name()] {
+				seen[v.Name()] = true
+				result = append(result, v)
+			}
+		}
+	}
+	return result
+}
+
+// benchPart measures count runs of part of s on file, each on a fresh Day so
+// that parsing the input is included.
+func benchPart(s day.Solver, part int, file string, count int, timeout time.Duration) (benchResult, error) {
+	result := benchResult{solver: s, part: part, input: file}
+	for i := 0; i < count; i++ {
+		ctx, cancel := day.WithTimeout(context.Background(), timeout)
+		ps, err := day.MeasurePart(ctx, s.New(file), part)
+		cancel()
+		if err != nil {
+			return result, err
+		}
+		result.runs = append(result.runs, ps.Total)
+	}
+	return result, nil
+}
+
+// writeBenchTable writes results as a table with one row per solver and part.
+// The last column compares the mean time with that of the fastest solver of
+// the same day and part.
+func writeBenchTable(w io.Writer, results []benchResult) error {
+	type dayPart struct{ day, part int }
+	fastest := make(map[dayPart]time.Duration)
+	for _, r := range results {
+		k := dayPart{r.solver.Day, r.part}
+		if f, ok := fastest[k]; !ok || r.mean().Wall < f {
+			fastest[k] = r.mean().Wall
+		}
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "solver\tpart\tinput\tmin\tmean\tallocs\talloc\tpeak heap\trelative\t")
+	for _, r := range results {
+		m := r.mean()
+		relative := 1.0
+		if f := fastest[dayPart{r.solver.Day, r.part}]; f > 0 {
+			relative = float64(m.Wall) / float64(f)
+		}
+		fmt.Fprintf(tw, "%s\t%d\t%s\t%v\t%v\t%d\t%s\t%s\t%.2fx\t\n",
+			r.solver.Name(), r.part, filepath.Base(r.input),
+			r.fastest().Round(time.Microsecond), m.Wall.Round(time.Microsecond),
+			m.Allocs, day.FormatBytes(m.Bytes), day.FormatBytes(m.PeakHeap), relative)
+	}
+	return tw.Flush()
+}
+
+func bench(args []string) error {
+	fs := flag.NewFlagSet("bench", flag.ContinueOnError)
+	all := fs.Bool("all", false, "benchmark every implementation of every day")
+	count := fs.Int("count", 5, "run each part this many times")
+	input := fs.String("input", "", "benchmark a single day on this file, or on stdin for -")
+	timeout := fs.Duration("timeout", 0, "give up on a run when it takes longer than this")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: aoc bench [--all] [--count n] [--input file] [--timeout d] [day | from-to | name ...]")
+		fmt.Fprintln(fs.Output(), "Days are benchmarked with all their implementations, on the real input when present and the largest example otherwise.")
+		fs.PrintDefaults()
+	}
+
+	positional, err := day.ParseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if *count < 1 {
+		return fmt.Errorf("invalid count %d", *count)
+	}
+
+	solvers, err := selectSolvers(positional, *all)
+	if err != nil {
+		return err
+	}
+	solvers = withVariants(solvers)
+	if len(solvers) == 0 {
+		fs.Usage()
+		return errUsage
+	}
+
+	inputFile := ""
+	if *input != "" {
+		for _, s := range solvers {
+			if s.Day != solvers[0].Day {
+				return errors.New("--input needs exactly one day")
+			}
+		}
+		file, cleanup, err := day.ResolveInput(*input, solvers[0].Day, "input.txt")
+		if err != nil {
+			return err
+		}
+		defer cleanup()
+		inputFile = file
+	}
+
+	failed := 0
+	results := make([]benchResult, 0, 2*len(solvers))
+	for _, s := range solvers {
+		for part := 1; part <= 2; part++ {
+			file := inputFile
+			if file == "" {
+				file, err = daytest.BenchInput(s, part)
+				if errors.Is(err, daytest.ErrNoBenchInput) {
+					fmt.Fprintf(os.Stderr, "%s part %d skipped: no input\n", s.Name(), part)
+					continue
+				}
+				if err != nil {
+					return err
+				}
+			}
+
+			r, err := benchPart(s, part, file, *count, *timeout)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "%s part %d: %v\n", s.Name(), part, err)
+				failed++
+				continue
+			}
+			results = append(results, r)
+		}
+	}
+
+	if err := writeBenchTable(os.Stdout, results); err != nil {
+		return err
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d parts failed", failed)
+	}
+	return nil
+}
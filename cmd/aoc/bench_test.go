@@ -0,0 +1,64 @@
+package main
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"adventofcode23/internal/day"
+)
+
+func TestWithVariants(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		args []string
+		want []string
+	}{
+		{[]string{"25"}, []string{"day25", "day25b"}},
+		{[]string{"25b"}, []string{"day25b"}},
+		{[]string{"4-5"}, []string{"day04", "day05", "day05b"}},
+	}
+
+	for _, test := range tests {
+		solvers, err := selectSolvers(test.args, false)
+		if err != nil {
+			t.Fatalf("%v: %v", test.args, err)
+		}
+		got := names(withVariants(solvers))
+		if !slices.Equal(test.want, got) {
+			t.Errorf("%v: want %v, got %v", test.args, test.want, got)
+		}
+	}
+}
+
+func TestWriteBenchTable(t *testing.T) {
+	t.Parallel()
+
+	day25, _ := day.Lookup("day25")
+	day25b, _ := day.Lookup("day25b")
+	results := []benchResult{
+		{day25, 1, "input.txt", []day.Measurement{{Wall: 3 * time.Millisecond}, {Wall: 5 * time.Millisecond}}},
+		{day25b, 1, "input.txt", []day.Measurement{{Wall: 2 * time.Millisecond}}},
+	}
+
+	var b strings.Builder
+	if err := writeBenchTable(&b, results); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(b.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("want a header and 2 rows, got %q", b.String())
+	}
+	for i, want := range []string{"3ms 4ms", "2ms 2ms"} {
+		if got := strings.Join(strings.Fields(lines[i+1])[3:5], " "); got != want {
+			t.Errorf("%s: want min and mean %s, got %s", lines[i+1], want, got)
+		}
+	}
+	for i, want := range []string{"2.00x", "1.00x"} {
+		if !strings.HasSuffix(lines[i+1], want) {
+			t.Errorf("want %s relative, got %q", want, lines[i+1])
+		}
+	}
+}
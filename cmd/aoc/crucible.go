@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"adventofcode23/internal/crucible"
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/grid"
+)
+
+// routeCrucible finds a route of least heat loss through a day 17 heat map,
+// which defaults to the real input, with the rules given by the flags.
+func routeCrucible(args []string) error {
+	fs := flag.NewFlagSet("crucible", flag.ContinueOnError)
+	input := fs.String("input", "", "read the heat map from this file, or from stdin for -")
+	minSteps := fs.Int("min", crucible.Crucible.MinSteps, "fewest blocks in a straight line")
+	maxSteps := fs.Int("max", crucible.Crucible.MaxSteps, "most blocks in a straight line")
+	reverse := fs.Bool("reverse", false, "allow turning around")
+	diagonal := fs.Bool("diagonal", false, "allow diagonal moves")
+	from := fs.String("from", "0,0", "start at this row,column")
+	to := fs.String("to", "", "end at this row,column instead of the bottom right")
+	astar := fs.Bool("astar", false, "search with A* instead of Dijkstra")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: aoc crucible [--input file] [--min n] [--max n] [--reverse] [--diagonal] [--from r,c] [--to r,c] [--astar]")
+		fs.PrintDefaults()
+	}
+	positional, err := day.ParseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 0 {
+		fs.Usage()
+		return errUsage
+	}
+	start, err := parsePoint(*from)
+	if err != nil {
+		return fmt.Errorf("--from: %w", err)
+	}
+
+	file, cleanup, err := day.ResolveInput(*input, 17, "input.txt")
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	lines, err := day.NewInput(file).ReadGrid(context.Background(), "0123456789")
+	if err != nil {
+		return err
+	}
+	m := crucible.NewMap(lines)
+	end := grid.Point{Row: m.Rows() - 1, Column: m.Columns() - 1}
+	if *to != "" {
+		if end, err = parsePoint(*to); err != nil {
+			return fmt.Errorf("--to: %w", err)
+		}
+	}
+
+	rules := crucible.Rules{MinSteps: *minSteps, MaxSteps: *maxSteps, Reverse: *reverse, Diagonal: *diagonal}
+	search := m.Dijkstra
+	if *astar {
+		search = m.AStar
+	}
+	route, err := search(context.Background(), rules, start, end)
+	if err != nil {
+		return err
+	}
+	writeRoute(os.Stdout, m, route)
+	return nil
+}
+
+// parsePoint parses a block given as row,column.
+func parsePoint(s string) (grid.Point, error) {
+	row, column, ok := strings.Cut(s, ",")
+	r, err := strconv.Atoi(row)
+	c, err2 := strconv.Atoi(column)
+	if !ok || err != nil || err2 != nil {
+		return grid.Point{}, fmt.Errorf("invalid block %q, want row,column", s)
+	}
+	return grid.Point{Row: r, Column: c}, nil
+}
+
+// writeRoute writes the heat loss and stops of route, and the heat map with
+// the blocks of the route in place of their heat loss.
+func writeRoute(w io.Writer, m crucible.Map, route crucible.Route) {
+	stops := make([]string, len(route.Stops))
+	for i, p := range route.Stops {
+		stops[i] = fmt.Sprintf("%d,%d", p.Row, p.Column)
+	}
+	fmt.Fprintf(w, "heat loss %d: %s\n", route.Loss, strings.Join(stops, " -> "))
+
+	lines := make([][]byte, m.Rows())
+	for r := range lines {
+		lines[r] = make([]byte, m.Columns())
+		for c, heat := range m.Row(r) {
+			lines[r][c] = byte('0' + heat)
+		}
+	}
+	for _, p := range route.Blocks() {
+		lines[p.Row][p.Column] = '#'
+	}
+	for _, line := range lines {
+		fmt.Fprintf(w, "%s\n", line)
+	}
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"adventofcode23/internal/crucible"
+	"adventofcode23/internal/grid"
+)
+
+func TestWriteRoute(t *testing.T) {
+	t.Parallel()
+	m := crucible.NewMap([]string{"1911", "1191", "9111"})
+	route, err := m.Dijkstra(context.Background(), crucible.Crucible, grid.Point{}, grid.Point{Row: 2, Column: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var b strings.Builder
+	writeRoute(&b, m, route)
+	want := `heat loss 5: 0,0 -> 1,0 -> 1,1 -> 2,1 -> 2,3
+#911
+##91
+9###
+`
+	if got := b.String(); got != want {
+		t.Errorf("want\n%s\ngot\n%s", want, got)
+	}
+}
+
+func TestParsePoint(t *testing.T) {
+	t.Parallel()
+	if p, err := parsePoint("3,12"); err != nil || p != (grid.Point{Row: 3, Column: 12}) {
+		t.Errorf("want 3,12, got %v, %v", p, err)
+	}
+	for _, s := range []string{"3", "3,", "a,1", ""} {
+		if _, err := parsePoint(s); err == nil {
+			t.Errorf("%q: want an error", s)
+		}
+	}
+}
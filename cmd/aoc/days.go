@@ -0,0 +1,36 @@
+package main
+
+// Every solver registers itself with the day registry on import.
+import (
+	_ "adventofcode23/internal/days/day01"
+	_ "adventofcode23/internal/days/day02"
+	_ "adventofcode23/internal/days/day03"
+	_ "adventofcode23/internal/days/day04"
+	_ "adventofcode23/internal/days/day05"
+	_ "adventofcode23/internal/days/day05b"
+	_ "adventofcode23/internal/days/day06"
+	_ "adventofcode23/internal/days/day07"
+	_ "adventofcode23/internal/days/day08"
+	_ "adventofcode23/internal/days/day09"
+	_ "adventofcode23/internal/days/day09b"
+	_ "adventofcode23/internal/days/day10"
+	_ "adventofcode23/internal/days/day11"
+	_ "adventofcode23/internal/days/day12"
+	_ "adventofcode23/internal/days/day13"
+	_ "adventofcode23/internal/days/day14"
+	_ "adventofcode23/internal/days/day14b"
+	_ "adventofcode23/internal/days/day15"
+	_ "adventofcode23/internal/days/day16"
+	_ "adventofcode23/internal/days/day17"
+	_ "adventofcode23/internal/days/day17b"
+	_ "adventofcode23/internal/days/day18"
+	_ "adventofcode23/internal/days/day18b"
+	_ "adventofcode23/internal/days/day19"
+	_ "adventofcode23/internal/days/day20"
+	_ "adventofcode23/internal/days/day21"
+	_ "adventofcode23/internal/days/day22"
+	_ "adventofcode23/internal/days/day23"
+	_ "adventofcode23/internal/days/day24"
+	_ "adventofcode23/internal/days/day25"
+	_ "adventofcode23/internal/days/day25b"
+)
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/input"
+)
+
+const year = 2023
+
+func fetch(args []string) error {
+	fs := flag.NewFlagSet("fetch", flag.ContinueOnError)
+	all := fs.Bool("all", false, "fetch the input of every day")
+	baseURL := fs.String("url", input.DefaultBaseURL, "base URL of the puzzle site")
+	cacheDir := fs.String("cache", "", "cache directory (default: the user cache directory)")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: aoc fetch [--all] [--url url] [--cache dir] [day | from-to ...]")
+		fmt.Fprintf(fs.Output(), "The session token is read from $%s. Point $%s at\n", input.SessionEnv, day.InputDirEnv)
+		fmt.Fprintf(fs.Output(), "<cache>/%d to solve the fetched inputs.\n", year)
+		fs.PrintDefaults()
+	}
+
+	positional, err := day.ParseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+
+	solvers, err := selectSolvers(positional, *all)
+	if err != nil {
+		return err
+	}
+	if len(solvers) == 0 {
+		fs.Usage()
+		return errUsage
+	}
+
+	if *cacheDir == "" {
+		if *cacheDir, err = input.DefaultCacheDir(); err != nil {
+			return err
+		}
+	}
+	f := input.NewFetcher(*baseURL, os.Getenv(input.SessionEnv), *cacheDir)
+
+	// variants share the input of their day
+	done := make(map[int]bool)
+	for _, s := range solvers {
+		if done[s.Day] {
+			continue
+		}
+		done[s.Day] = true
+
+		path, err := f.Get(context.Background(), year, s.Day)
+		if err != nil {
+			return err
+		}
+		fmt.Println(path)
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/gen"
+)
+
+func generate(args []string) error {
+	fs := flag.NewFlagSet("gen", flag.ContinueOnError)
+	seed := fs.Int64("seed", 1, "seed of the random input; the same seed gives the same input")
+	size := fs.Int("size", 0, "size of the input, its meaning depending on the day; 0 for the size of a real input")
+	out := fs.String("out", "", "write the input to this file instead of stdout")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: aoc gen [--seed n] [--size n] [--out file] day")
+		fmt.Fprintln(fs.Output(), "Answers the input is built to have are reported on stderr.")
+		fmt.Fprint(fs.Output(), "Days with a generator:")
+		for _, n := range gen.Days() {
+			g, _ := gen.Lookup(n)
+			fmt.Fprintf(fs.Output(), " %d (size %d)", n, g.Size)
+		}
+		fmt.Fprintln(fs.Output())
+		fs.PrintDefaults()
+	}
+
+	positional, err := day.ParseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		fs.Usage()
+		return errUsage
+	}
+	m := dayRE.FindStringSubmatch(positional[0])
+	if m == nil || m[2] != "" {
+		return fmt.Errorf("invalid day %q", positional[0])
+	}
+	n, _ := strconv.Atoi(m[1])
+
+	p, err := gen.Generate(n, *seed, *size)
+	if err != nil {
+		return err
+	}
+
+	if err := writePuzzle(p, *out); err != nil {
+		return err
+	}
+	for part := 1; part <= 2; part++ {
+		if answer, ok := p.Answers[part]; ok {
+			fmt.Fprintf(os.Stderr, "part %d: %v\n", part, answer)
+		}
+	}
+	return nil
+}
+
+// writePuzzle writes the input of p to the file out, or to stdout when out
+// is empty.
+func writePuzzle(p gen.Puzzle, out string) error {
+	if out == "" {
+		_, err := p.WriteTo(os.Stdout)
+		return err
+	}
+
+	f, err := os.Create(out)
+	if err != nil {
+		return err
+	}
+	if _, err := p.WriteTo(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
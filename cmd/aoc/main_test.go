@@ -0,0 +1,67 @@
+package main
+
+import (
+	"slices"
+	"testing"
+
+	"adventofcode23/internal/day"
+)
+
+func names(solvers []day.Solver) []string {
+	result := make([]string, len(solvers))
+	for i, s := range solvers {
+		result[i] = s.Name()
+	}
+	return result
+}
+
+func TestSelectSolvers(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		args []string
+		all  bool
+		want []string
+	}{
+		{[]string{"5"}, false, []string{"day05"}},
+		{[]string{"12-14"}, false, []string{"day12", "day13", "day14"}},
+		{[]string{"day05b", "5"}, false, []string{"day05", "day05b"}},
+		{[]string{"25b", "24-25"}, false, []string{"day24", "day25", "day25b"}},
+	}
+
+	for _, test := range tests {
+		solvers, err := selectSolvers(test.args, test.all)
+		if err != nil {
+			t.Fatalf("%v: %v", test.args, err)
+		}
+		got := names(solvers)
+		if !slices.Equal(test.want, got) {
+			t.Errorf("%v: want %v, got %v", test.args, test.want, got)
+		}
+	}
+}
+
+func TestSelectAll(t *testing.T) {
+	t.Parallel()
+
+	solvers, err := selectSolvers([]string{"day17b"}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := 26
+	got := len(solvers)
+	if want != got {
+		t.Errorf("want %d, got %d", want, got)
+	}
+}
+
+func TestSelectInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, arg := range []string{"14-12", "26", "day03b", "x"} {
+		if _, err := selectSolvers([]string{arg}, false); err == nil {
+			t.Errorf("%s: want error, got nil", arg)
+		}
+	}
+}
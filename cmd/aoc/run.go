@@ -0,0 +1,245 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"time"
+
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/parallel"
+	"adventofcode23/internal/render"
+)
+
+var (
+	dayRE   = regexp.MustCompile(`^(?:day)?(\d+)([a-z]*)$`)
+	rangeRE = regexp.MustCompile(`^(\d+)-(\d+)$`)
+)
+
+// selectSolvers translates the command line selection into solvers. A
+// selection is a day number (5), a range of days (12-14) or the name of a
+// specific implementation (day05b or 5b). Plain days and ranges select the
+// primary implementation of each day.
+func selectSolvers(args []string, all bool) ([]day.Solver, error) {
+	selected := make(map[string]day.Solver)
+
+	if all {
+		for _, s := range day.Solvers() {
+			if !s.IsVariant() {
+				selected[s.Name()] = s
+			}
+		}
+	}
+
+	for _, arg := range args {
+		solvers, err := selectArg(arg)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range solvers {
+			selected[s.Name()] = s
+		}
+	}
+
+	// keep registry order
+	result := make([]day.Solver, 0, len(selected))
+	for _, s := range day.Solvers() {
+		if _, ok := selected[s.Name()]; ok {
+			result = append(result, s)
+		}
+	}
+	return result, nil
+}
+
+func selectArg(arg string) ([]day.Solver, error) {
+	if m := rangeRE.FindStringSubmatch(arg); m != nil {
+		from, _ := strconv.Atoi(m[1])
+		to, _ := strconv.Atoi(m[2])
+		if from > to {
+			return nil, fmt.Errorf("invalid range %s", arg)
+		}
+		result := make([]day.Solver, 0, to-from+1)
+		for n := from; n <= to; n++ {
+			s, err := lookup(n, "")
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, s)
+		}
+		return result, nil
+	}
+
+	if m := dayRE.FindStringSubmatch(arg); m != nil {
+		n, _ := strconv.Atoi(m[1])
+		s, err := lookup(n, m[2])
+		if err != nil {
+			return nil, err
+		}
+		return []day.Solver{s}, nil
+	}
+
+	return nil, fmt.Errorf("invalid day %q", arg)
+}
+
+func lookup(n int, variant string) (day.Solver, error) {
+	name := day.Solver{Day: n, Variant: variant}.Name()
+	s, ok := day.Lookup(name)
+	if !ok {
+		return day.Solver{}, fmt.Errorf("no solver registered for %s", name)
+	}
+	return s, nil
+}
+
+func run(args []string) error {
+	fs := flag.NewFlagSet("run", flag.ContinueOnError)
+	all := fs.Bool("all", false, "run the primary implementation of every day")
+	input := fs.String("input", "", "read the input of a single day from this file, or from stdin for -")
+	var format day.StatsFormat
+	fs.Var(&format, "stats", "report time and memory of each part as text or json")
+	timeout := fs.Duration("timeout", 0, "give up on a day when solving it takes longer than this")
+	jobs := fs.Int("jobs", 0, "solve this many days at once; 0 for one per CPU, or 1 with --stats or --animate ansi")
+	var visuals day.Visuals
+	visuals.Flags(fs)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: aoc run [--all] [--jobs n] [--input file] [--stats[=json]] [--timeout d] [--render format] [--animate format [--fps n]] [--dot] [day | from-to | name ...]")
+		fs.PrintDefaults()
+	}
+
+	positional, err := day.ParseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+
+	solvers, err := selectSolvers(positional, *all)
+	if err != nil {
+		return err
+	}
+	if len(solvers) == 0 {
+		fs.Usage()
+		return errUsage
+	}
+
+	inputFile := ""
+	if *input != "" {
+		if len(solvers) != 1 {
+			return errors.New("--input needs exactly one day")
+		}
+		file, cleanup, err := day.ResolveInput(*input, solvers[0].Day, "input.txt")
+		if err != nil {
+			return err
+		}
+		defer cleanup()
+		inputFile = file
+	}
+
+	if *jobs < 0 {
+		return fmt.Errorf("invalid number of jobs %d", *jobs)
+	}
+	workers := *jobs
+	if workers == 0 {
+		workers = parallel.Workers()
+		// stats count allocations of the whole process, and terminal
+		// animations need the screen to themselves
+		if format != day.NoStats || visuals.Movie == render.Terminal {
+			workers = 1
+		}
+	}
+
+	// output of concurrent solvers is buffered and written in order
+	type outcome struct {
+		out, log bytes.Buffer
+		stats    day.Stats
+		err      error
+	}
+	outcomes := make([]outcome, len(solvers))
+	failed := 0
+	stats := make([]day.Stats, 0, len(solvers))
+	work := func(i int) {
+		s, o := solvers[i], &outcomes[i]
+		file := inputFile
+		if file == "" {
+			file = s.InputFile()
+		}
+
+		var out, log io.Writer = os.Stdout, os.Stderr
+		if workers > 1 {
+			out, log = &o.out, &o.log
+		}
+		o.stats, o.err = runSolver(s, file, format, visuals, *timeout, out, log)
+	}
+	emit := func(i int) {
+		s, o := solvers[i], &outcomes[i]
+		os.Stdout.Write(o.out.Bytes())
+		os.Stderr.Write(o.log.Bytes())
+		switch {
+		case errors.Is(o.err, context.DeadlineExceeded):
+			fmt.Fprintf(os.Stderr, "%s: timed out after %v: %v\n", s.Name(), *timeout, o.err)
+			failed++
+		case o.err != nil:
+			fmt.Fprintf(os.Stderr, "%s: %v\n", s.Name(), o.err)
+			failed++
+		case format != day.NoStats:
+			stats = append(stats, o.stats)
+		}
+	}
+	if err := parallel.Ordered(context.Background(), workers, len(solvers), work, emit); err != nil {
+		return err
+	}
+
+	if format != day.NoStats {
+		if err := day.WriteStats(os.Stdout, format, stats); err != nil {
+			return err
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d solvers failed", failed, len(solvers))
+	}
+	return nil
+}
+
+// runSolver solves both parts of s on file within timeout, printing the
+// answers to out unless format asks for stats. It then draws the parts as
+// visuals select, to out or, with stats, to log. Days that cannot draw are
+// skipped silently, so that --render, --animate and --dot work with --all.
+func runSolver(s day.Solver, file string, format day.StatsFormat, visuals day.Visuals, timeout time.Duration, out, log io.Writer) (day.Stats, error) {
+	ctx, cancel := day.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	p := s.New(file)
+	var st day.Stats
+	var err error
+	if format == day.NoStats {
+		fmt.Fprintln(out, s.Name())
+		err = day.Run(ctx, out, p)
+	} else {
+		st, err = day.Measure(ctx, s.Name(), p)
+	}
+	if err != nil {
+		return st, err
+	}
+
+	w := out
+	if format != day.NoStats {
+		// keep stats, written to stdout as a whole at the end, parseable
+		w = log
+	}
+	err = visuals.Draw(ctx, w, s.Name(), p)
+	if errors.Is(err, day.ErrCannotRender) || errors.Is(err, day.ErrCannotAnimate) || errors.Is(err, day.ErrCannotGraph) {
+		return st, nil
+	}
+	return st, err
+}
+
+func list(args []string) error {
+	for _, s := range day.Solvers() {
+		fmt.Println(s.Name())
+	}
+	return nil
+}
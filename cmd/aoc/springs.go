@@ -0,0 +1,78 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/springs"
+)
+
+// countSprings counts the arrangements of day 12 rows given on the command
+// line, or of the rows of an input, which defaults to the real input.
+func countSprings(args []string) error {
+	fs := flag.NewFlagSet("springs", flag.ContinueOnError)
+	input := fs.String("input", "", "read the rows from this file, or from stdin for -")
+	unfold := fs.Int("unfold", 1, "unfold every row into this many copies")
+	list := fs.Int("list", 0, "list up to this many arrangements of every row")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: aoc springs [--input file] [--unfold n] [--list n] [row ...]")
+		fmt.Fprintln(fs.Output(), `Rows on the command line are quoted, as in "???.### 1,1,3".`)
+		fs.PrintDefaults()
+	}
+	positional, err := day.ParseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if *unfold < 1 || *list < 0 || len(positional) > 0 && *input != "" {
+		fs.Usage()
+		return errUsage
+	}
+
+	var rows []springs.Row
+	for i, arg := range positional {
+		r, err := springs.Parse(arg)
+		if err != nil {
+			return fmt.Errorf("row %d: %w", i+1, err)
+		}
+		rows = append(rows, r)
+	}
+	if len(rows) == 0 {
+		file, cleanup, err := day.ResolveInput(*input, 12, "input.txt")
+		if err != nil {
+			return err
+		}
+		defer cleanup()
+		if rows, err = day.ParseLines(context.Background(), day.NewInput(file), springs.Parse); err != nil {
+			return err
+		}
+	}
+	writeArrangements(os.Stdout, rows, *unfold, *list)
+	return nil
+}
+
+// writeArrangements writes the number of arrangements of every row unfolded
+// factor times, followed by up to list of them, and the total.
+func writeArrangements(w io.Writer, rows []springs.Row, factor, list int) {
+	total := new(big.Int)
+	for _, r := range rows {
+		r = r.Unfold(factor)
+		count := r.Count()
+		total.Add(total, count)
+		fmt.Fprintf(w, "%v: %v\n", r, count)
+		if list == 0 {
+			continue
+		}
+		e := r.Arrangements(list)
+		for a, ok := e.Next(); ok; a, ok = e.Next() {
+			fmt.Fprintf(w, "  %s\n", a)
+		}
+	}
+	if len(rows) > 1 {
+		fmt.Fprintf(w, "total: %v\n", total)
+	}
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"adventofcode23/internal/springs"
+)
+
+func TestWriteArrangements(t *testing.T) {
+	t.Parallel()
+	var rows []springs.Row
+	for _, line := range []string{"?#? 1", "?? 1"} {
+		r, err := springs.Parse(line)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rows = append(rows, r)
+	}
+
+	var b strings.Builder
+	writeArrangements(&b, rows, 2, 2)
+	want := `?#???#? 1,1: 1
+  .#...#.
+????? 1,1: 6
+  #.#..
+  #..#.
+total: 7
+`
+	if got := b.String(); got != want {
+		t.Errorf("want\n%s\ngot\n%s", want, got)
+	}
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"adventofcode23/internal/day"
+)
+
+var errNoInput = errors.New("no input")
+
+// answersFile is where the recorded answers of s live, shared by all variants
+// of a day.
+func answersFile(s day.Solver) string {
+	return day.InputFile(s.Day, day.AnswersFile)
+}
+
+// verifySolver checks the answers of s on its input against the recorded
+// answers. When there are none yet and record is set, it records the answers
+// of s instead. It returns errNoInput, or an error matching fs.ErrNotExist
+// for missing answers, when there is nothing to verify.
+func verifySolver(ctx context.Context, s day.Solver, record bool) error {
+	if _, err := os.Stat(s.InputFile()); err != nil {
+		return errNoInput
+	}
+
+	want, err := day.ReadAnswers(answersFile(s))
+	if errors.Is(err, fs.ErrNotExist) && record {
+		got, err := day.SolveAll(ctx, s.New(s.InputFile()))
+		if err != nil {
+			return err
+		}
+		return day.WriteAnswers(answersFile(s), got)
+	}
+	if err != nil {
+		return err
+	}
+
+	return day.Verify(ctx, s.New(s.InputFile()), want)
+}
+
+func verify(args []string) error {
+	flags := flag.NewFlagSet("verify", flag.ContinueOnError)
+	record := flags.Bool("record", false, "record the answers of days without recorded answers")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: aoc verify [--record] [day | from-to | name ...]")
+		fmt.Fprintf(flags.Output(), "Without days, every registered solver is verified against %s.\n", day.AnswersFile)
+		flags.PrintDefaults()
+	}
+
+	positional, err := day.ParseFlags(flags, args)
+	if err != nil {
+		return err
+	}
+
+	solvers := day.Solvers()
+	if len(positional) > 0 {
+		if solvers, err = selectSolvers(positional, false); err != nil {
+			return err
+		}
+	}
+
+	failed := 0
+	for _, s := range solvers {
+		err := verifySolver(context.Background(), s, *record)
+		switch {
+		case err == nil:
+			fmt.Printf("%s ok\n", s.Name())
+		case errors.Is(err, errNoInput):
+			fmt.Printf("%s skipped: no input\n", s.Name())
+		case errors.Is(err, fs.ErrNotExist):
+			fmt.Printf("%s skipped: no recorded answers\n", s.Name())
+		default:
+			fmt.Printf("%s FAILED: %v\n", s.Name(), err)
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d solvers failed", failed, len(solvers))
+	}
+	return nil
+}
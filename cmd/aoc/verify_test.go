@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/projectpath"
+)
+
+// TestAnswers runs every registered solver on its real input and compares
+// the answers to the recorded ones. Solvers without an input or recorded
+// answers are skipped; record answers with 'aoc verify --record'.
+func TestAnswers(t *testing.T) {
+	if testing.Short() {
+		t.Skip("solving real inputs takes a while")
+	}
+
+	for _, s := range day.Solvers() {
+		s := s
+		t.Run(s.Name(), func(t *testing.T) {
+			t.Parallel()
+
+			err := verifySolver(context.Background(), s, false)
+			switch {
+			case errors.Is(err, errNoInput):
+				t.Skip("no input")
+			case errors.Is(err, fs.ErrNotExist):
+				t.Skip("no recorded answers")
+			case err != nil:
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestVerifySolver(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv(day.InputDirEnv, dir)
+
+	example, err := os.ReadFile(filepath.Join(projectpath.Root, "cmd", "day05", "example.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, _ := day.Lookup("day05b")
+
+	if err := verifySolver(context.Background(), s, false); !errors.Is(err, errNoInput) {
+		t.Fatalf("want %v, got %v", errNoInput, err)
+	}
+
+	if err := os.MkdirAll(filepath.Join(dir, "day05"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(s.InputFile(), example, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := verifySolver(context.Background(), s, false); !errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("want %v, got %v", fs.ErrNotExist, err)
+	}
+
+	// record with one variant, verify another
+	if err := verifySolver(context.Background(), s, true); err != nil {
+		t.Fatal(err)
+	}
+	s, _ = day.Lookup("day05")
+	if err := verifySolver(context.Background(), s, false); err != nil {
+		t.Fatal(err)
+	}
+
+	wrong := day.Answers{Part1: day.Int(35), Part2: day.Int(47)}
+	if err := day.WriteAnswers(answersFile(s), wrong); err != nil {
+		t.Fatal(err)
+	}
+	err = verifySolver(context.Background(), s, true)
+	var me *day.MismatchError
+	if !errors.As(err, &me) || me.Part != 2 {
+		t.Errorf("want mismatch in part 2, got %v", err)
+	}
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/workflow"
+)
+
+// workflows analyses the workflows of a day 19 input, which defaults to the
+// real input.
+func workflows(args []string) error {
+	fs := flag.NewFlagSet("workflows", flag.ContinueOnError)
+	input := fs.String("input", "", "read the workflows from this file, or from stdin for -")
+	lo := fs.Int("min", 1, "lowest rating in every category")
+	hi := fs.Int("max", 4000, "highest rating in every category")
+	paths := fs.Bool("paths", false, "list the ways to A with the ratings taking them")
+	eliminate := fs.Bool("eliminate", false, "print the workflows without the rules and workflows never reached")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: aoc workflows [--input file] [--min n] [--max n] [--paths] [--eliminate]")
+		fmt.Fprintln(fs.Output(), "Reports cycles, rules never taken and workflows never reached.")
+		fs.PrintDefaults()
+	}
+	positional, err := day.ParseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 0 {
+		fs.Usage()
+		return errUsage
+	}
+
+	file, cleanup, err := day.ResolveInput(*input, 19, "input.txt")
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	s, err := day.Parse(context.Background(), day.NewInput(file), workflow.Parse)
+	if err != nil {
+		return err
+	}
+	return analyse(os.Stdout, s, workflow.Bounds(s.Categories(), *lo, *hi), *paths, *eliminate)
+}
+
+func analyse(w io.Writer, s *workflow.System, bounds workflow.Box, paths, eliminate bool) error {
+	if cycle, ok := s.Cycle(); ok {
+		fmt.Fprintf(w, "%v: workflows %s form a cycle\n", s.Workflows[cycle[0]].Pos, strings.Join(cycle, " -> "))
+	}
+	a, err := s.Analyze(bounds)
+	if err != nil {
+		return err
+	}
+	unreachable := make(map[string]bool, len(a.Unreachable))
+	for _, name := range a.Unreachable {
+		unreachable[name] = true
+		fmt.Fprintf(w, "%v: workflow %s is never reached\n", s.Workflows[name].Pos, name)
+	}
+	for _, r := range a.Dead {
+		if !unreachable[r.Workflow] {
+			fmt.Fprintf(w, "%v: rule %v of %s is never taken\n", s.Rule(r).Pos, s.Rule(r), r.Workflow)
+		}
+	}
+
+	if paths {
+		accepted, err := s.Accepted(bounds)
+		if err != nil {
+			return err
+		}
+		for _, p := range accepted {
+			fmt.Fprintln(w, p)
+		}
+	}
+	if eliminate {
+		e, err := s.Eliminate(bounds)
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(w, e)
+	}
+	return nil
+}
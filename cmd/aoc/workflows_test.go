@@ -0,0 +1,34 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"adventofcode23/internal/workflow"
+)
+
+func TestAnalyse(t *testing.T) {
+	t.Parallel()
+	s, err := workflow.Parse([]string{"in{x<10:a,x<5:R,b}", "a{x>20:R,x<2:in,A}", "b{A}", "c{R}"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var b strings.Builder
+	if err := analyse(&b, s, workflow.Bounds([]string{"x"}, 2, 4000), true, true); err != nil {
+		t.Fatal(err)
+	}
+	want := `1:1: workflows in -> a -> in form a cycle
+4:1: workflow c is never reached
+1:11: rule x<5:R of in is never taken
+2:3: rule x>20:R of a is never taken
+2:10: rule x<2:in of a is never taken
+in -> a -> A {x=2..9}
+in -> b -> A {x=10..4000}
+in{A}
+
+`
+	if got := b.String(); got != want {
+		t.Errorf("want\n%s\ngot\n%s", want, got)
+	}
+}
@@ -0,0 +1,183 @@
+// Package circuit simulates networks of modules that pass high and low
+// pulses to each other, like the machine of day 20. A Circuit is the wiring;
+// a Sim runs pulses through it, one at a time and in the order they are
+// sent, and can be traced, stopped at breakpoints, and rewound to snapshots.
+package circuit
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+var (
+	ErrDuplicate     = errors.New("duplicate module")
+	ErrNoBroadcaster = errors.New("no broadcaster module")
+)
+
+// Kind is how a module reacts to the pulses it receives.
+type Kind int
+
+const (
+	// Output modules, which are only named as destinations, ignore pulses.
+	Output Kind = iota
+	// FlipFlop modules ignore high pulses. A low pulse flips them on or
+	// off, and they send high when turned on and low when turned off.
+	FlipFlop
+	// Conjunction modules remember the last pulse from each source. They
+	// send low when all of them were high, and high otherwise.
+	Conjunction
+	// Broadcaster modules pass every pulse on.
+	Broadcaster
+)
+
+func (k Kind) String() string {
+	switch k {
+	case FlipFlop:
+		return "flip-flop"
+	case Conjunction:
+		return "conjunction"
+	case Broadcaster:
+		return "broadcaster"
+	default:
+		return "output"
+	}
+}
+
+// Stateful reports whether modules of kind k remember anything between
+// pulses.
+func (k Kind) Stateful() bool {
+	return k == FlipFlop || k == Conjunction
+}
+
+// Module describes a module and where it sends its pulses.
+type Module struct {
+	Name         string
+	Kind         Kind
+	Destinations []string
+}
+
+// Broadcast is the name of the module the button sends its low pulse to.
+const Broadcast = "broadcaster"
+
+// Button is the source of the pulse sent by pushing the button.
+const Button = "button"
+
+// Circuit is the wiring of modules. Modules are numbered in order of name.
+type Circuit struct {
+	names []string
+	index map[string]int
+	kinds []Kind
+	// sources and destinations by number; slots[m][k] is the position of m
+	// among the sources of destinations[m][k]
+	sources, destinations, slots [][]int
+}
+
+// New wires up modules. Destinations that are not among modules become
+// Output modules. One of the modules has to be the broadcaster.
+func New(modules []Module) (*Circuit, error) {
+	kinds := make(map[string]Kind, len(modules))
+	for _, m := range modules {
+		if _, ok := kinds[m.Name]; ok {
+			return nil, fmt.Errorf("%w %s", ErrDuplicate, m.Name)
+		}
+		kinds[m.Name] = m.Kind
+	}
+	if _, ok := kinds[Broadcast]; !ok {
+		return nil, ErrNoBroadcaster
+	}
+	for _, m := range modules {
+		for _, d := range m.Destinations {
+			if _, ok := kinds[d]; !ok {
+				kinds[d] = Output
+			}
+		}
+	}
+
+	c := &Circuit{index: make(map[string]int, len(kinds))}
+	for name := range kinds {
+		c.names = append(c.names, name)
+	}
+	sort.Strings(c.names)
+	for i, name := range c.names {
+		c.index[name] = i
+		c.kinds = append(c.kinds, kinds[name])
+	}
+
+	n := len(c.names)
+	c.sources, c.destinations, c.slots = make([][]int, n), make([][]int, n), make([][]int, n)
+	// add sources in order of module name, so that they do not depend on
+	// the order of modules
+	sorted := append([]Module(nil), modules...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+	for _, m := range sorted {
+		from := c.index[m.Name]
+		for _, d := range m.Destinations {
+			to := c.index[d]
+			c.destinations[from] = append(c.destinations[from], to)
+			c.slots[from] = append(c.slots[from], len(c.sources[to]))
+			c.sources[to] = append(c.sources[to], from)
+		}
+	}
+	return c, nil
+}
+
+// Names returns the names of all modules in order.
+func (c *Circuit) Names() []string {
+	return append([]string(nil), c.names...)
+}
+
+// Has reports whether there is a module called name.
+func (c *Circuit) Has(name string) bool {
+	_, ok := c.index[name]
+	return ok
+}
+
+// Kind returns the kind of the module called name.
+func (c *Circuit) Kind(name string) Kind {
+	return c.kinds[c.index[name]]
+}
+
+func (c *Circuit) lookup(numbers []int) []string {
+	result := make([]string, len(numbers))
+	for i, n := range numbers {
+		result[i] = c.names[n]
+	}
+	return result
+}
+
+// Sources returns the modules that send pulses to name, in order of name.
+func (c *Circuit) Sources(name string) []string {
+	return c.lookup(c.sources[c.index[name]])
+}
+
+// Destinations returns the modules name sends pulses to, in the order they
+// receive them.
+func (c *Circuit) Destinations(name string) []string {
+	return c.lookup(c.destinations[c.index[name]])
+}
+
+// Cone returns the modules whose pulses can reach name, including name
+// itself, in order.
+func (c *Circuit) Cone(name string) []string {
+	start := c.index[name]
+	seen := map[int]bool{start: true}
+	todo := []int{start}
+	for len(todo) > 0 {
+		m := todo[0]
+		todo = todo[1:]
+		for _, s := range c.sources[m] {
+			if !seen[s] {
+				seen[s] = true
+				todo = append(todo, s)
+			}
+		}
+	}
+
+	result := make([]string, 0, len(seen))
+	for m := range seen {
+		result = append(result, c.names[m])
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,141 @@
+package circuit
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+// example is the second example of day 20.
+func example(t *testing.T) *Circuit {
+	t.Helper()
+	c, err := New([]Module{
+		{"broadcaster", Broadcaster, []string{"a"}},
+		{"a", FlipFlop, []string{"inv", "con"}},
+		{"inv", Conjunction, []string{"b"}},
+		{"b", FlipFlop, []string{"con"}},
+		{"con", Conjunction, []string{"output"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestWiring(t *testing.T) {
+	t.Parallel()
+	c := example(t)
+
+	if got := c.Kind("output"); got != Output {
+		t.Errorf("output: want %v, got %v", Output, got)
+	}
+	if want, got := []string{"a", "b"}, c.Sources("con"); !slices.Equal(want, got) {
+		t.Errorf("sources of con: want %v, got %v", want, got)
+	}
+	if want, got := []string{"inv", "con"}, c.Destinations("a"); !slices.Equal(want, got) {
+		t.Errorf("destinations of a: want %v, got %v", want, got)
+	}
+	if want, got := []string{"a", "b", "broadcaster", "inv"}, c.Cone("b"); !slices.Equal(want, got) {
+		t.Errorf("cone of b: want %v, got %v", want, got)
+	}
+}
+
+func TestNewErrors(t *testing.T) {
+	t.Parallel()
+
+	_, err := New([]Module{{"a", FlipFlop, nil}})
+	if !errors.Is(err, ErrNoBroadcaster) {
+		t.Errorf("want %v, got %v", ErrNoBroadcaster, err)
+	}
+	_, err = New([]Module{{"broadcaster", Broadcaster, nil}, {"broadcaster", FlipFlop, nil}})
+	if !errors.Is(err, ErrDuplicate) {
+		t.Errorf("want %v, got %v", ErrDuplicate, err)
+	}
+}
+
+func TestPress(t *testing.T) {
+	t.Parallel()
+	sim := NewSim(example(t))
+
+	var trace []Pulse
+	sim.Trace(func(p Pulse) { trace = append(trace, p) })
+	sim.Press()
+	want := []Pulse{
+		{"button", "broadcaster", false},
+		{"broadcaster", "a", false},
+		{"a", "inv", true},
+		{"a", "con", true},
+		{"inv", "b", false},
+		{"con", "output", true},
+		{"b", "con", true},
+		{"con", "output", false},
+	}
+	if !slices.Equal(want, trace) {
+		t.Errorf("want pulses %v, got %v", want, trace)
+	}
+
+	sim.Trace(nil)
+	for i := 1; i < 1000; i++ {
+		sim.Press()
+	}
+	low, high := sim.Counts()
+	if low != 4250 || high != 2750 {
+		t.Errorf("want 4250 low and 2750 high pulses, got %d and %d", low, high)
+	}
+}
+
+func TestBreakpoints(t *testing.T) {
+	t.Parallel()
+	sim := NewSim(example(t))
+	sim.Break(Breakpoint{Module: "con", High: false})
+
+	want := Pulse{"con", "output", false}
+	p, hit := sim.Press()
+	if !hit || p != want {
+		t.Fatalf("want break at %v, got %v, %v", want, p, hit)
+	}
+	if got := sim.Module("b"); !got.On {
+		t.Errorf("want b on at the break, got %+v", got)
+	}
+
+	if _, hit := sim.Run(); hit {
+		t.Errorf("want the first press to end without another break")
+	}
+	// a turns off in the second press, and on again in the third
+	if p, hit := sim.Press(); hit {
+		t.Errorf("press 2: want no break, got %v", p)
+	}
+	if p, hit := sim.Press(); !hit || p != want {
+		t.Errorf("press 3: want break at %v, got %v, %v", want, p, hit)
+	}
+
+	sim.ClearBreaks()
+	if _, hit := sim.Run(); hit || !sim.AtRest() {
+		t.Errorf("want no breaks after clearing them")
+	}
+}
+
+func TestSnapshot(t *testing.T) {
+	t.Parallel()
+	sim := NewSim(example(t))
+	sim.Press()
+	snap := sim.Snapshot()
+	key := sim.Key(sim.Circuit().Names())
+
+	for i := 0; i < 2; i++ {
+		sim.Press()
+		sim.Press()
+		sim.Restore(snap)
+		if got := sim.Key(sim.Circuit().Names()); got != key {
+			t.Errorf("want state %q after restoring, got %q", key, got)
+		}
+		if sim.Presses() != 1 {
+			t.Errorf("want 1 press after restoring, got %d", sim.Presses())
+		}
+	}
+
+	state := sim.Module("con")
+	if want := []bool{true, true}; state.Kind != Conjunction || !slices.Equal(want, state.Memory) {
+		t.Errorf("con: want conjunction remembering %v, got %+v", want, state)
+	}
+}
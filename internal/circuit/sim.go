@@ -0,0 +1,258 @@
+package circuit
+
+import "strings"
+
+// Pulse is a pulse sent from one module to another.
+type Pulse struct {
+	Source, Destination string
+	High                bool
+}
+
+// signal is a pulse between modules by number. from is -1 for the button;
+// slot is the position of from among the sources of to.
+type signal struct {
+	from, to, slot int
+	high           bool
+}
+
+// Breakpoint stops a Sim when Module sends a pulse of the given level.
+type Breakpoint struct {
+	Module string
+	High   bool
+}
+
+// ModuleState is what a module remembers.
+type ModuleState struct {
+	Kind Kind
+	// On is whether a flip-flop is on.
+	On bool
+	// Memory is the last pulse a conjunction received from each source, in
+	// the order of Circuit.Sources.
+	Memory []bool
+}
+
+// Sim runs pulses through a Circuit. All modules start off, and all
+// conjunctions start out remembering low pulses.
+type Sim struct {
+	c      *Circuit
+	on     []bool
+	memory [][]bool
+	lows   []int // number of sources a conjunction remembers low
+	queue  []signal
+	head   int
+
+	presses, low, high int
+
+	trace  func(Pulse)
+	breaks map[Breakpoint]bool
+}
+
+func NewSim(c *Circuit) *Sim {
+	s := &Sim{
+		c:      c,
+		on:     make([]bool, len(c.names)),
+		memory: make([][]bool, len(c.names)),
+		lows:   make([]int, len(c.names)),
+		breaks: make(map[Breakpoint]bool),
+	}
+	for m, sources := range c.sources {
+		s.memory[m] = make([]bool, len(sources))
+		s.lows[m] = len(sources)
+	}
+	return s
+}
+
+// Circuit returns the circuit s simulates.
+func (s *Sim) Circuit() *Circuit {
+	return s.c
+}
+
+// Trace calls f with every pulse once it has been processed, or no longer
+// when f is nil.
+func (s *Sim) Trace(f func(Pulse)) {
+	s.trace = f
+}
+
+// Break makes Run stop after processing a pulse matching b.
+func (s *Sim) Break(b Breakpoint) {
+	s.breaks[b] = true
+}
+
+// ClearBreaks removes all breakpoints.
+func (s *Sim) ClearBreaks() {
+	clear(s.breaks)
+}
+
+// Presses returns the number of times the button has been pushed.
+func (s *Sim) Presses() int {
+	return s.presses
+}
+
+// Counts returns the number of low and high pulses processed so far.
+func (s *Sim) Counts() (low, high int) {
+	return s.low, s.high
+}
+
+// AtRest reports whether no pulses are on their way.
+func (s *Sim) AtRest() bool {
+	return s.head == len(s.queue)
+}
+
+func (s *Sim) pulse(sig signal) Pulse {
+	source := Button
+	if sig.from >= 0 {
+		source = s.c.names[sig.from]
+	}
+	return Pulse{source, s.c.names[sig.to], sig.high}
+}
+
+func (s *Sim) send(from int, high bool) {
+	for k, to := range s.c.destinations[from] {
+		s.queue = append(s.queue, signal{from, to, s.c.slots[from][k], high})
+	}
+}
+
+// Step processes the next pulse and returns it. It returns false when the
+// circuit is at rest.
+func (s *Sim) Step() (Pulse, bool) {
+	if s.AtRest() {
+		s.queue, s.head = s.queue[:0], 0
+		return Pulse{}, false
+	}
+	sig := s.queue[s.head]
+	s.head++
+	if sig.high {
+		s.high++
+	} else {
+		s.low++
+	}
+
+	m := sig.to
+	switch s.c.kinds[m] {
+	case FlipFlop:
+		if !sig.high {
+			s.on[m] = !s.on[m]
+			s.send(m, s.on[m])
+		}
+	case Conjunction:
+		if remembered := s.memory[m][sig.slot]; remembered != sig.high {
+			s.memory[m][sig.slot] = sig.high
+			if sig.high {
+				s.lows[m]--
+			} else {
+				s.lows[m]++
+			}
+		}
+		s.send(m, s.lows[m] > 0)
+	case Broadcaster:
+		s.send(m, sig.high)
+	}
+
+	p := s.pulse(sig)
+	if s.trace != nil {
+		s.trace(p)
+	}
+	return p, true
+}
+
+// Run processes pulses until the circuit is at rest, or until a pulse
+// matches a breakpoint, which it returns. Calling Run again resumes.
+func (s *Sim) Run() (Pulse, bool) {
+	for {
+		p, ok := s.Step()
+		if !ok {
+			return Pulse{}, false
+		}
+		if s.breaks[Breakpoint{p.Source, p.High}] {
+			return p, true
+		}
+	}
+}
+
+// Press pushes the button, which sends a low pulse to the broadcaster, and
+// runs the circuit as Run does.
+func (s *Sim) Press() (Pulse, bool) {
+	s.presses++
+	s.queue = append(s.queue, signal{-1, s.c.index[Broadcast], 0, false})
+	return s.Run()
+}
+
+// Module returns the state of the module called name.
+func (s *Sim) Module(name string) ModuleState {
+	m := s.c.index[name]
+	result := ModuleState{Kind: s.c.kinds[m]}
+	switch result.Kind {
+	case FlipFlop:
+		result.On = s.on[m]
+	case Conjunction:
+		result.Memory = append([]bool(nil), s.memory[m]...)
+	}
+	return result
+}
+
+// Key encodes the state of the named modules, so that it can be compared
+// with the state at other times.
+func (s *Sim) Key(names []string) string {
+	var b strings.Builder
+	bit := func(on bool) {
+		if on {
+			b.WriteByte('1')
+		} else {
+			b.WriteByte('0')
+		}
+	}
+	for _, name := range names {
+		m := s.c.index[name]
+		switch s.c.kinds[m] {
+		case FlipFlop:
+			bit(s.on[m])
+		case Conjunction:
+			for _, on := range s.memory[m] {
+				bit(on)
+			}
+		}
+		b.WriteByte(' ')
+	}
+	return b.String()
+}
+
+// Snapshot is the complete state of a Sim at one time, including the pulses
+// on their way and the counts.
+type Snapshot struct {
+	on                 []bool
+	memory             [][]bool
+	lows               []int
+	queue              []signal
+	presses, low, high int
+}
+
+// Snapshot returns the state of s, to be restored later.
+func (s *Sim) Snapshot() Snapshot {
+	return Snapshot{
+		on:      append([]bool(nil), s.on...),
+		memory:  cloneMemory(s.memory),
+		lows:    append([]int(nil), s.lows...),
+		queue:   append([]signal(nil), s.queue[s.head:]...),
+		presses: s.presses,
+		low:     s.low,
+		high:    s.high,
+	}
+}
+
+// Restore returns s to the state of snap. A snapshot can be restored any
+// number of times.
+func (s *Sim) Restore(snap Snapshot) {
+	s.on = append(s.on[:0], snap.on...)
+	s.memory = cloneMemory(snap.memory)
+	s.lows = append(s.lows[:0], snap.lows...)
+	s.queue, s.head = append(s.queue[:0], snap.queue...), 0
+	s.presses, s.low, s.high = snap.presses, snap.low, snap.high
+}
+
+func cloneMemory(memory [][]bool) [][]bool {
+	result := make([][]bool, len(memory))
+	for i, m := range memory {
+		result[i] = append([]bool(nil), m...)
+	}
+	return result
+}
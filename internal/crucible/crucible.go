@@ -0,0 +1,179 @@
+// Package crucible finds the routes of least heat loss for the crucibles of
+// day 17. A crucible moves in straight runs, and the heat loss of a run is
+// the sum of the blocks it enters. Rules say how long a run may be, whether
+// the next run may turn around or go diagonally, and how far a crucible may
+// have run when it enters a given block.
+package crucible
+
+import (
+	"errors"
+	"fmt"
+
+	"adventofcode23/internal/grid"
+)
+
+var (
+	ErrUnreachable = errors.New("the end cannot be reached")
+	ErrOutside     = errors.New("block outside the map")
+	ErrRules       = errors.New("invalid movement rules")
+)
+
+// Rules say how a crucible moves. Every run is between MinSteps and MaxSteps
+// blocks long, and the next one goes another way: sideways, or also back
+// with Reverse. With Diagonal, runs may also go diagonally, and every turn of
+// 45 degrees or more counts.
+type Rules struct {
+	MinSteps, MaxSteps int
+	Reverse            bool
+	Diagonal           bool
+
+	// Limit, if not nil, returns the most blocks a crucible may have moved
+	// in a straight line when it enters p. A limit of 0 keeps it out of p.
+	Limit func(p grid.Point) int
+}
+
+var (
+	// Crucible is the crucible of part 1.
+	Crucible = Rules{MinSteps: 1, MaxSteps: 3}
+	// Ultra is the ultra crucible of part 2.
+	Ultra = Rules{MinSteps: 4, MaxSteps: 10}
+)
+
+// headings holds the offsets of one step in every heading, clockwise from
+// north, so orthogonal headings are even and the reverse of h is h+4.
+var headings = [...]grid.Point{
+	{Row: -1}, {Row: -1, Column: 1}, {Column: 1}, {Row: 1, Column: 1},
+	{Row: 1}, {Row: 1, Column: -1}, {Column: -1}, {Row: -1, Column: -1},
+}
+
+func (r Rules) check() error {
+	if r.MinSteps < 1 || r.MaxSteps < r.MinSteps {
+		return fmt.Errorf("%w: runs of %d to %d blocks", ErrRules, r.MinSteps, r.MaxSteps)
+	}
+	return nil
+}
+
+// headings returns the headings runs may take.
+func (r Rules) headings() []int {
+	if r.Diagonal {
+		return []int{0, 1, 2, 3, 4, 5, 6, 7}
+	}
+	return []int{0, 2, 4, 6}
+}
+
+// fold returns what the next run needs to know of a run in heading h: its
+// axis, unless the next run may go back along it.
+func (r Rules) fold(h int) int {
+	if r.Reverse {
+		return h
+	}
+	return h % 4
+}
+
+// distance returns the fewest blocks a crucible moves from p to q, ignoring
+// how long its runs must be.
+func (r Rules) distance(p, q grid.Point) int {
+	if !r.Diagonal {
+		return p.Manhattan(q)
+	}
+	d := p.Sub(q)
+	return max(d.Row, -d.Row, d.Column, -d.Column)
+}
+
+// state is where a crucible stops, with the folded heading of its last run.
+type state struct {
+	point   grid.Point
+	heading int
+}
+
+// starts returns the states a crucible can leave p from in every heading.
+func (r Rules) starts(p grid.Point) []state {
+	var result []state
+	for _, h := range r.headings() {
+		if r.fold(h) == h {
+			result = append(result, state{p, h})
+		}
+	}
+	return result
+}
+
+// Map is a heat map: the heat loss of every block.
+type Map struct {
+	grid.Grid[int]
+}
+
+// NewMap returns the heat map of lines of digits.
+func NewMap(lines []string) Map {
+	return Map{grid.Map(grid.Bytes(lines), func(ch byte) int { return int(ch - '0') })}
+}
+
+// runs calls visit with every stop of a run from s and its heat loss.
+func (m Map) runs(r Rules, s state, visit func(to state, loss int)) {
+	for _, h := range r.headings() {
+		to := r.fold(h)
+		if to == s.heading {
+			continue
+		}
+		p, loss := s.point, 0
+		for k := 1; k <= r.MaxSteps; k++ {
+			p = p.Add(headings[h])
+			if !m.In(p) || r.Limit != nil && k > r.Limit(p) {
+				// the run can go no further
+				break
+			}
+			loss += m.Get(p)
+			if k >= r.MinSteps {
+				visit(state{p, to}, loss)
+			}
+		}
+	}
+}
+
+func (m Map) check(r Rules, points ...grid.Point) error {
+	if err := r.check(); err != nil {
+		return err
+	}
+	for _, p := range points {
+		if !m.In(p) {
+			return fmt.Errorf("%w: %d,%d", ErrOutside, p.Row, p.Column)
+		}
+	}
+	return nil
+}
+
+// Route is a way through a heat map.
+type Route struct {
+	// Stops holds the start, the end and every block between where the
+	// crucible turns.
+	Stops []grid.Point
+	Loss  int
+}
+
+// Blocks returns every block of the route in order, the start included.
+func (r Route) Blocks() []grid.Point {
+	if len(r.Stops) == 0 {
+		return nil
+	}
+	result := []grid.Point{r.Stops[0]}
+	for i := 1; i < len(r.Stops); i++ {
+		from, to := r.Stops[i-1], r.Stops[i]
+		d := to.Sub(from)
+		step := grid.Point{Row: sign(d.Row), Column: sign(d.Column)}
+		for p := from; p != to; {
+			p = p.Add(step)
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
+func sign(n int) int {
+	switch {
+	case n < 0:
+		return -1
+	case n > 0:
+		return 1
+	default:
+		return 0
+	}
+}
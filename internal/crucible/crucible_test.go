@@ -0,0 +1,238 @@
+package crucible
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"adventofcode23/internal/grid"
+)
+
+var example = NewMap(strings.Fields(`
+	2413432311323
+	3215453535623
+	3255245654254
+	3446585845452
+	4546657867536
+	1438598798454
+	4457876987766
+	3637877979653
+	4654967986887
+	4564679986453
+	1224686865563
+	2546548887735
+	4322674655533
+`))
+
+type search func(ctx context.Context, m Map, r Rules, start, end grid.Point) (Route, error)
+
+var searches = map[string]search{
+	"dijkstra": func(ctx context.Context, m Map, r Rules, start, end grid.Point) (Route, error) {
+		return m.Dijkstra(ctx, r, start, end)
+	},
+	"astar": func(ctx context.Context, m Map, r Rules, start, end grid.Point) (Route, error) {
+		return m.AStar(ctx, r, start, end)
+	},
+}
+
+func corner(m Map) grid.Point {
+	return grid.Point{Row: m.Rows() - 1, Column: m.Columns() - 1}
+}
+
+// checkRoute reports whether route is a way from start to end that r allows,
+// with the heat loss of its blocks.
+func checkRoute(m Map, r Rules, route Route, start, end grid.Point) error {
+	if len(route.Stops) == 0 || route.Stops[0] != start || route.Stops[len(route.Stops)-1] != end {
+		return fmt.Errorf("want a route from %v to %v, got %v", start, end, route.Stops)
+	}
+	loss := 0
+	for _, p := range route.Blocks()[1:] {
+		loss += m.Get(p)
+	}
+	if loss != route.Loss {
+		return fmt.Errorf("want a loss of %d along %v, got %d", loss, route.Stops, route.Loss)
+	}
+
+	var last grid.Point
+	for i := 1; i < len(route.Stops); i++ {
+		d := route.Stops[i].Sub(route.Stops[i-1])
+		steps := r.distance(route.Stops[i], route.Stops[i-1])
+		step := grid.Point{Row: d.Row / steps, Column: d.Column / steps}
+		if step.Scale(steps) != d || !r.Diagonal && step.Row != 0 && step.Column != 0 {
+			return fmt.Errorf("%v: run from %v to %v is not straight", route.Stops, route.Stops[i-1], route.Stops[i])
+		}
+		if steps < r.MinSteps || steps > r.MaxSteps {
+			return fmt.Errorf("%v: run of %d blocks", route.Stops, steps)
+		}
+		if step == last || !r.Reverse && step == last.Scale(-1) {
+			return fmt.Errorf("%v: no turn at %v", route.Stops, route.Stops[i-1])
+		}
+		last = step
+	}
+	return nil
+}
+
+func TestExample(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		rules Rules
+		want  int
+	}{
+		{Crucible, 102},
+		{Ultra, 94},
+	}
+	for name, find := range searches {
+		for _, test := range tests {
+			route, err := find(context.Background(), example, test.rules, grid.Point{}, corner(example))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if route.Loss != test.want {
+				t.Errorf("%s %+v: want %d, got %d", name, test.rules, test.want, route.Loss)
+			}
+			if err := checkRoute(example, test.rules, route, grid.Point{}, corner(example)); err != nil {
+				t.Errorf("%s: %v", name, err)
+			}
+		}
+	}
+}
+
+func TestRoute(t *testing.T) {
+	t.Parallel()
+	m := NewMap([]string{"111111111111", "999999999991", "999999999991", "999999999991", "999999999991"})
+
+	for name, find := range searches {
+		route, err := find(context.Background(), m, Ultra, grid.Point{}, corner(m))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if route.Loss != 71 {
+			t.Errorf("%s: want 71, got %d", name, route.Loss)
+		}
+		// the crucible turns south after 7 or 8 blocks
+		if len(route.Stops) != 4 || route.Stops[2].Row != 4 {
+			t.Errorf("%s: want two turns, got %v", name, route.Stops)
+		}
+		if err := checkRoute(m, Ultra, route, grid.Point{}, corner(m)); err != nil {
+			t.Errorf("%s: %v", name, err)
+		}
+		if blocks := route.Blocks(); len(blocks) != 4+11+1 {
+			t.Errorf("%s: want 16 blocks, got %v", name, blocks)
+		}
+	}
+}
+
+func TestRules(t *testing.T) {
+	t.Parallel()
+	ones := NewMap([]string{"111", "111", "111"})
+	// walls at 0,1 and 1,1 keep the crucible to the bottom row
+	walls := func(p grid.Point) int {
+		if p.Column == 1 && p.Row < 2 {
+			return 0
+		}
+		return 3
+	}
+
+	tests := []struct {
+		name       string
+		m          Map
+		rules      Rules
+		start, end grid.Point
+		want       int
+	}{
+		{"reverse", NewMap([]string{"11111"}), Rules{MinSteps: 1, MaxSteps: 3, Reverse: true}, grid.Point{}, grid.Point{Column: 4}, 6},
+		{"diagonal", NewMap([]string{"19", "91"}), Rules{MinSteps: 1, MaxSteps: 3, Diagonal: true}, grid.Point{}, grid.Point{Row: 1, Column: 1}, 1},
+		{"no diagonal", NewMap([]string{"19", "91"}), Crucible, grid.Point{}, grid.Point{Row: 1, Column: 1}, 10},
+		{"walls", ones, Rules{MinSteps: 1, MaxSteps: 3, Limit: walls}, grid.Point{}, grid.Point{Column: 2}, 6},
+		{"no walls", ones, Crucible, grid.Point{}, grid.Point{Column: 2}, 2},
+		{"backwards", example, Crucible, corner(example), grid.Point{}, 101},
+		{"middle", example, Ultra, grid.Point{Row: 6, Column: 6}, grid.Point{Row: 2, Column: 1}, 43},
+		{"start is end", example, Ultra, grid.Point{Row: 3, Column: 4}, grid.Point{Row: 3, Column: 4}, 0},
+		{
+			"limit of 1",
+			example, Rules{MinSteps: 1, MaxSteps: 3, Limit: func(grid.Point) int { return 1 }},
+			grid.Point{}, corner(example), 133,
+		},
+	}
+	for name, find := range searches {
+		for _, test := range tests {
+			route, err := find(context.Background(), test.m, test.rules, test.start, test.end)
+			if err != nil {
+				t.Fatalf("%s %s: %v", name, test.name, err)
+			}
+			if route.Loss != test.want {
+				t.Errorf("%s %s: want %d, got %d along %v", name, test.name, test.want, route.Loss, route.Stops)
+			}
+			if err := checkRoute(test.m, test.rules, route, test.start, test.end); err != nil {
+				t.Errorf("%s %s: %v", name, test.name, err)
+			}
+			if test.rules.Limit != nil {
+				for _, p := range route.Blocks()[1:] {
+					if test.rules.Limit(p) == 0 {
+						t.Errorf("%s %s: want no route through %v, got %v", name, test.name, p, route.Stops)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestAStarAgrees(t *testing.T) {
+	t.Parallel()
+	every := []Rules{
+		Crucible, Ultra,
+		{MinSteps: 2, MaxSteps: 5, Reverse: true},
+		{MinSteps: 1, MaxSteps: 2, Diagonal: true},
+		{MinSteps: 3, MaxSteps: 6, Reverse: true, Diagonal: true},
+		{MinSteps: 1, MaxSteps: 4, Limit: func(p grid.Point) int { return (p.Row + p.Column) % 5 }},
+	}
+	points := []grid.Point{{}, {Row: 12, Column: 12}, {Row: 0, Column: 12}, {Row: 5, Column: 7}, {Row: 9, Column: 2}}
+
+	for _, r := range every {
+		n, err := example.Network(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, start := range points {
+			for _, end := range points {
+				want, err := n.Dijkstra(context.Background(), start, end)
+				got, err2 := example.AStar(context.Background(), r, start, end)
+				if !errors.Is(err2, err) || got.Loss != want.Loss {
+					t.Errorf("%+v from %v to %v: want %d, %v, got %d, %v", r, start, end, want.Loss, err, got.Loss, err2)
+				}
+				if err == nil {
+					if err := checkRoute(example, r, got, start, end); err != nil {
+						t.Error(err)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestErrors(t *testing.T) {
+	t.Parallel()
+	m := NewMap([]string{"11", "11"})
+
+	tests := []struct {
+		rules Rules
+		end   grid.Point
+		want  error
+	}{
+		{Ultra, grid.Point{Row: 1, Column: 1}, ErrUnreachable},
+		{Crucible, grid.Point{Row: 2, Column: 1}, ErrOutside},
+		{Rules{MinSteps: 0, MaxSteps: 3}, grid.Point{Row: 1, Column: 1}, ErrRules},
+		{Rules{MinSteps: 4, MaxSteps: 3}, grid.Point{Row: 1, Column: 1}, ErrRules},
+	}
+	for name, find := range searches {
+		for _, test := range tests {
+			_, err := find(context.Background(), m, test.rules, grid.Point{}, test.end)
+			if !errors.Is(err, test.want) {
+				t.Errorf("%s %+v to %v: want %v, got %v", name, test.rules, test.end, test.want, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package crucible
+
+import (
+	"context"
+	"errors"
+	"math"
+	"slices"
+
+	"adventofcode23/internal/graph"
+	"adventofcode23/internal/grid"
+)
+
+// Network connects every stop on a heat map to the stops one run away.
+type Network struct {
+	m     Map
+	rules Rules
+	graph *graph.Graph[state]
+}
+
+// Network returns the network of the runs r allows on m.
+func (m Map) Network(r Rules) (*Network, error) {
+	if err := r.check(); err != nil {
+		return nil, err
+	}
+	g := graph.NewDirected[state]()
+	for row := 0; row < m.Rows(); row++ {
+		for column := 0; column < m.Columns(); column++ {
+			for _, from := range r.starts(grid.Point{Row: row, Column: column}) {
+				g.AddNode(from)
+				m.runs(r, from, func(to state, loss int) {
+					g.AddEdge(from, to, loss)
+				})
+			}
+		}
+	}
+	return &Network{m, r, g}, nil
+}
+
+// Dijkstra returns a route of least heat loss from start to end, found by
+// graph.Dijkstra.
+func (n *Network) Dijkstra(ctx context.Context, start, end grid.Point) (Route, error) {
+	if err := n.m.check(n.rules, start, end); err != nil {
+		return Route{}, err
+	}
+	path, err := graph.Dijkstra(ctx, n.graph, n.rules.starts(start), func(s state) bool {
+		return s.point == end
+	})
+	if errors.Is(err, graph.ErrNoPath) {
+		return Route{}, ErrUnreachable
+	}
+	if err != nil {
+		return Route{}, err
+	}
+	stops := make([]grid.Point, len(path.Nodes))
+	for i, s := range path.Nodes {
+		stops[i] = s.point
+	}
+	return Route{stops, path.Cost}, nil
+}
+
+// Dijkstra returns a route of least heat loss from start to end with the
+// rules r. It builds the network first, which takes longer than the search.
+func (m Map) Dijkstra(ctx context.Context, r Rules, start, end grid.Point) (Route, error) {
+	n, err := m.Network(r)
+	if err != nil {
+		return Route{}, err
+	}
+	return n.Dijkstra(ctx, start, end)
+}
+
+// AStar returns a route of least heat loss from start to end with the rules
+// r, like Dijkstra but without a network. It follows the runs from every
+// stop as it reaches it, and goes for the stops with the least heat loss so
+// far plus the least heat loss to the end: the distance times the lowest
+// heat loss of any block. The distance is Manhattan unless the rules allow
+// diagonal runs, which can go as far in the same number of blocks.
+func (m Map) AStar(ctx context.Context, r Rules, start, end grid.Point) (Route, error) {
+	if err := m.check(r, start, end); err != nil {
+		return Route{}, err
+	}
+
+	lowest, highest := slices.Min(m.Cells()), slices.Max(m.Cells())
+	estimate := func(p grid.Point) int {
+		return lowest * r.distance(p, end)
+	}
+	id := func(s state) int {
+		return (s.point.Row*m.Columns()+s.point.Column)*len(headings) + s.heading
+	}
+
+	type entry struct {
+		s    state
+		loss int
+	}
+
+	// a run adds at most MaxSteps*highest to the heat loss and
+	// MaxSteps*lowest to the estimate, so the buckets wrap around no queued
+	// stop
+	buckets := make([][]entry, r.MaxSteps*(highest+lowest)+1)
+	loss := make([]int, m.Rows()*m.Columns()*len(headings))
+	for i := range loss {
+		loss[i] = math.MaxInt
+	}
+	prev := make([]state, len(loss))
+	first := estimate(start)
+	queued := 0
+	for _, s := range r.starts(start) {
+		loss[id(s)] = 0
+		prev[id(s)] = s
+		buckets[first%len(buckets)] = append(buckets[first%len(buckets)], entry{s, 0})
+		queued++
+	}
+
+	for f := first; queued > 0; f++ {
+		if err := ctx.Err(); err != nil {
+			return Route{}, err
+		}
+
+		index := f % len(buckets)
+		for len(buckets[index]) > 0 {
+			e := buckets[index][0]
+			buckets[index] = buckets[index][1:]
+			queued--
+
+			if e.loss > loss[id(e.s)] {
+				continue
+			}
+			if e.s.point == end {
+				return route(prev, id, e.s, e.loss), nil
+			}
+
+			m.runs(r, e.s, func(to state, run int) {
+				l := e.loss + run
+				if l >= loss[id(to)] {
+					return
+				}
+				loss[id(to)] = l
+				prev[id(to)] = e.s
+				next := (l + estimate(to.point)) % len(buckets)
+				buckets[next] = append(buckets[next], entry{to, l})
+				queued++
+			})
+		}
+	}
+
+	return Route{}, ErrUnreachable
+}
+
+// route follows prev back from end to a start, which is its own
+// predecessor.
+func route(prev []state, id func(state) int, end state, loss int) Route {
+	stops := []grid.Point{end.point}
+	for s := end; prev[id(s)] != s; {
+		s = prev[id(s)]
+		stops = append(stops, s.point)
+	}
+	slices.Reverse(stops)
+	return Route{stops, loss}
+}
@@ -0,0 +1,132 @@
+// Package cycle finds where iterated functions start repeating: a sequence
+// x, f(x), f(f(x)), ... over finitely many states eventually enters a loop.
+package cycle
+
+import "context"
+
+// Cycle describes a sequence that enters a loop: from step Start on, the
+// states repeat every Period steps.
+type Cycle struct {
+	Start, Period int
+}
+
+// Index returns the first step whose state equals the state of step n.
+func (c Cycle) Index(n int) int {
+	if n < c.Start {
+		return n
+	}
+	return c.Start + (n-c.Start)%c.Period
+}
+
+// Len returns the number of distinct states of the sequence.
+func (c Cycle) Len() int {
+	return c.Start + c.Period
+}
+
+// Floyd finds the cycle of the sequence start, next(start), ... with Floyd's
+// tortoise and hare, keeping no more than two states at a time. next must not
+// modify its argument.
+func Floyd[S any](ctx context.Context, start S, next func(S) S, equal func(a, b S) bool) (Cycle, error) {
+	tortoise, hare := next(start), next(next(start))
+	for !equal(tortoise, hare) {
+		if err := ctx.Err(); err != nil {
+			return Cycle{}, err
+		}
+		tortoise, hare = next(tortoise), next(next(hare))
+	}
+
+	// the distance from start to the loop equals the distance from the
+	// meeting point to the loop
+	result := Cycle{Period: 1}
+	for tortoise = start; !equal(tortoise, hare); result.Start++ {
+		tortoise, hare = next(tortoise), next(hare)
+	}
+	for hare = next(tortoise); !equal(tortoise, hare); result.Period++ {
+		hare = next(hare)
+	}
+	return result, nil
+}
+
+// Brent finds the cycle of the sequence start, next(start), ... with Brent's
+// algorithm, which calls next fewer times than Floyd. next must not modify
+// its argument.
+func Brent[S any](ctx context.Context, start S, next func(S) S, equal func(a, b S) bool) (Cycle, error) {
+	result := Cycle{Period: 1}
+	power := 1
+	tortoise, hare := start, next(start)
+	for !equal(tortoise, hare) {
+		if err := ctx.Err(); err != nil {
+			return Cycle{}, err
+		}
+		if power == result.Period {
+			tortoise = hare
+			power *= 2
+			result.Period = 0
+		}
+		hare = next(hare)
+		result.Period++
+	}
+
+	tortoise, hare = start, start
+	for i := 0; i < result.Period; i++ {
+		hare = next(hare)
+	}
+	for !equal(tortoise, hare) {
+		tortoise, hare = next(tortoise), next(hare)
+		result.Start++
+	}
+	return result, nil
+}
+
+// At returns the state of step n of the sequence start, next(start), ...,
+// taking no more than c.Len() steps.
+func At[S any](start S, next func(S) S, c Cycle, n int) S {
+	result := start
+	for i := c.Index(n); i > 0; i-- {
+		result = next(result)
+	}
+	return result
+}
+
+// Detector finds the cycle of a sequence from the keys of its states, which
+// it is given one step at a time. Keys must be equal exactly when states are;
+// a hash of the state will do when collisions are unlikely enough.
+type Detector[K comparable] struct {
+	seen map[K]int
+}
+
+func NewDetector[K comparable]() *Detector[K] {
+	return &Detector[K]{make(map[K]int)}
+}
+
+// Steps returns the number of keys added so far.
+func (d *Detector[K]) Steps() int {
+	return len(d.seen)
+}
+
+// Add records the key of the next state. Once the key was added before, it
+// returns the cycle and true; the detector then no longer changes.
+func (d *Detector[K]) Add(key K) (Cycle, bool) {
+	if i, ok := d.seen[key]; ok {
+		return Cycle{i, len(d.seen) - i}, true
+	}
+	d.seen[key] = len(d.seen)
+	return Cycle{}, false
+}
+
+// Find iterates next from start until a key repeats. It returns the cycle
+// and the states of its first c.Len() steps, so that the state of step n is
+// states[c.Index(n)]. next must not modify its argument.
+func Find[S any, K comparable](ctx context.Context, start S, next func(S) S, key func(S) K) (Cycle, []S, error) {
+	d := NewDetector[K]()
+	states := make([]S, 0)
+	for s := start; ; s = next(s) {
+		if err := ctx.Err(); err != nil {
+			return Cycle{}, nil, err
+		}
+		if c, ok := d.Add(key(s)); ok {
+			return c, states, nil
+		}
+		states = append(states, s)
+	}
+}
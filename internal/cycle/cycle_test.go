@@ -0,0 +1,93 @@
+package cycle
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+// naive finds the cycle by remembering every state.
+func naive(start int, next func(int) int) Cycle {
+	seen := make(map[int]int)
+	for s, i := start, 0; ; s, i = next(s), i+1 {
+		if j, ok := seen[s]; ok {
+			return Cycle{j, i - j}
+		}
+		seen[s] = i
+	}
+}
+
+func equal(a, b int) bool {
+	return a == b
+}
+
+func identity(s int) int {
+	return s
+}
+
+func TestFinders(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	for _, m := range []int{1, 2, 7, 100, 255, 1000} {
+		next := func(s int) int {
+			return (s*s + 1) % m
+		}
+		for start := 0; start < m; start += m/10 + 1 {
+			want := naive(start, next)
+			floyd, err := Floyd(ctx, start, next, equal)
+			if err != nil || floyd != want {
+				t.Errorf("m = %d, start = %d: Floyd: want %v, got %v, %v", m, start, want, floyd, err)
+			}
+			brent, err := Brent(ctx, start, next, equal)
+			if err != nil || brent != want {
+				t.Errorf("m = %d, start = %d: Brent: want %v, got %v, %v", m, start, want, brent, err)
+			}
+			found, states, err := Find(ctx, start, next, identity)
+			if err != nil || found != want || len(states) != want.Len() {
+				t.Errorf("m = %d, start = %d: Find: want %v, got %v with %d states, %v", m, start, want, found, len(states), err)
+			}
+		}
+	}
+}
+
+func TestExtrapolate(t *testing.T) {
+	t.Parallel()
+	next := func(s int) int {
+		return (s*s + 1) % 1000
+	}
+	c, states, err := Find(context.Background(), 3, next, identity)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := 3
+	for n := 0; n < 3*c.Len(); n++ {
+		if got := states[c.Index(n)]; got != s {
+			t.Fatalf("step %d: want %d, got %d", n, s, got)
+		}
+		if got := At(3, next, c, n); got != s {
+			t.Fatalf("At step %d: want %d, got %d", n, s, got)
+		}
+		s = next(s)
+	}
+}
+
+func TestCanceled(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// counting up never repeats
+	next := func(s int) int {
+		return s + 1
+	}
+	if _, err := Floyd(ctx, 0, next, equal); !errors.Is(err, context.Canceled) {
+		t.Errorf("Floyd: want %v, got %v", context.Canceled, err)
+	}
+	if _, err := Brent(ctx, 0, next, equal); !errors.Is(err, context.Canceled) {
+		t.Errorf("Brent: want %v, got %v", context.Canceled, err)
+	}
+	if _, _, err := Find(ctx, 0, next, identity); !errors.Is(err, context.Canceled) {
+		t.Errorf("Find: want %v, got %v", context.Canceled, err)
+	}
+}
@@ -0,0 +1,137 @@
+package day
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+)
+
+type answerKind int
+
+const (
+	notApplicable answerKind = iota
+	integer
+	bigInteger
+	text
+)
+
+// Answer is the solution to one part of a puzzle: an integer of arbitrary
+// size, a string, or nothing at all for parts that do not exist. The zero
+// value is NotApplicable.
+type Answer struct {
+	kind answerKind
+	n    int64
+	b    *big.Int
+	s    string
+}
+
+// NotApplicable is the answer to a part a puzzle does not have, like the
+// second part of day 25.
+var NotApplicable = Answer{}
+
+func Int(n int) Answer {
+	return Int64(int64(n))
+}
+
+func Int64(n int64) Answer {
+	return Answer{kind: integer, n: n}
+}
+
+// BigInt returns an answer for n, which is stored as an int64 when it fits.
+func BigInt(n *big.Int) Answer {
+	if n.IsInt64() {
+		return Int64(n.Int64())
+	}
+	return Answer{kind: bigInteger, b: new(big.Int).Set(n)}
+}
+
+func String(s string) Answer {
+	return Answer{kind: text, s: s}
+}
+
+func (a Answer) IsNotApplicable() bool {
+	return a.kind == notApplicable
+}
+
+// Int64 returns the answer as an int64, if it is an integer that fits.
+func (a Answer) Int64() (int64, bool) {
+	return a.n, a.kind == integer
+}
+
+// BigInt returns the answer as a big.Int, if it is an integer.
+func (a Answer) BigInt() (*big.Int, bool) {
+	switch a.kind {
+	case integer:
+		return big.NewInt(a.n), true
+	case bigInteger:
+		return new(big.Int).Set(a.b), true
+	default:
+		return nil, false
+	}
+}
+
+func (a Answer) Equal(b Answer) bool {
+	if a.kind != b.kind {
+		return false
+	}
+
+	switch a.kind {
+	case integer:
+		return a.n == b.n
+	case bigInteger:
+		return a.b.Cmp(b.b) == 0
+	case text:
+		return a.s == b.s
+	default:
+		return true
+	}
+}
+
+func (a Answer) String() string {
+	switch a.kind {
+	case integer:
+		return strconv.FormatInt(a.n, 10)
+	case bigInteger:
+		return a.b.String()
+	case text:
+		return a.s
+	default:
+		return "n/a"
+	}
+}
+
+// MarshalJSON encodes integers as JSON numbers, strings as JSON strings and
+// NotApplicable as null.
+func (a Answer) MarshalJSON() ([]byte, error) {
+	switch a.kind {
+	case integer, bigInteger:
+		return []byte(a.String()), nil
+	case text:
+		return json.Marshal(a.s)
+	default:
+		return []byte("null"), nil
+	}
+}
+
+func (a *Answer) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*a = NotApplicable
+		return nil
+	}
+	if data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		*a = String(s)
+		return nil
+	}
+
+	n, ok := new(big.Int).SetString(string(data), 10)
+	if !ok {
+		return fmt.Errorf("invalid answer %s", data)
+	}
+	*a = BigInt(n)
+	return nil
+}
@@ -0,0 +1,45 @@
+package day
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestAnswerString(t *testing.T) {
+	t.Parallel()
+
+	huge, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	tests := []struct {
+		answer Answer
+		want   string
+	}{
+		{Int(42), "42"},
+		{Int64(-7), "-7"},
+		{BigInt(huge), "123456789012345678901234567890"},
+		{String("24,13,10"), "24,13,10"},
+		{NotApplicable, "n/a"},
+	}
+
+	for _, test := range tests {
+		if got := test.answer.String(); test.want != got {
+			t.Errorf("want %q, got %q", test.want, got)
+		}
+	}
+}
+
+func TestAnswerEqual(t *testing.T) {
+	t.Parallel()
+
+	if !Int(42).Equal(BigInt(big.NewInt(42))) {
+		t.Error("small big.Int answer should equal int answer")
+	}
+	if Int(42).Equal(String("42")) {
+		t.Error("int answer should not equal string answer")
+	}
+	if Int(0).Equal(NotApplicable) {
+		t.Error("zero should not equal NotApplicable")
+	}
+	if !(Answer{}).Equal(NotApplicable) {
+		t.Error("zero Answer should be NotApplicable")
+	}
+}
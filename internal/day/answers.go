@@ -0,0 +1,81 @@
+package day
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// AnswersFile is the name of the file with the recorded answers of a day. It
+// lives next to the input file, see InputFile.
+const AnswersFile = "answers.json"
+
+// Answers are the recorded answers to both parts of a puzzle input.
+type Answers struct {
+	Part1 Answer `json:"part1"`
+	Part2 Answer `json:"part2"`
+}
+
+// MismatchError reports an answer that differs from the recorded one.
+type MismatchError struct {
+	Part      int
+	Want, Got Answer
+}
+
+func (e *MismatchError) Error() string {
+	return fmt.Sprintf("part %d: want %v, got %v", e.Part, e.Want, e.Got)
+}
+
+func ReadAnswers(path string) (Answers, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Answers{}, err
+	}
+
+	var answers Answers
+	if err := json.Unmarshal(data, &answers); err != nil {
+		return Answers{}, fmt.Errorf("%s: %w", path, err)
+	}
+	return answers, nil
+}
+
+func WriteAnswers(path string, answers Answers) error {
+	data, err := json.MarshalIndent(answers, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// SolveAll returns the answers to both parts of p.
+func SolveAll(ctx context.Context, p Day) (Answers, error) {
+	part1, err := solvePart(ctx, p.Part1)
+	if err != nil {
+		return Answers{}, fmt.Errorf("part 1: %w", err)
+	}
+	part2, err := solvePart(ctx, p.Part2)
+	if err != nil {
+		return Answers{}, fmt.Errorf("part 2: %w", err)
+	}
+	return Answers{part1, part2}, nil
+}
+
+// Verify solves both parts of p and compares the answers to want. Every part
+// with a different answer is reported as a *MismatchError.
+func Verify(ctx context.Context, p Day, want Answers) error {
+	got, err := SolveAll(ctx, p)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	if !want.Part1.Equal(got.Part1) {
+		errs = append(errs, &MismatchError{1, want.Part1, got.Part1})
+	}
+	if !want.Part2.Equal(got.Part2) {
+		errs = append(errs, &MismatchError{2, want.Part2, got.Part2})
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,30 @@
+package day
+
+import (
+	"math/big"
+	"path/filepath"
+	"testing"
+)
+
+func TestAnswersRoundTrip(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), AnswersFile)
+
+	huge, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	for _, want := range []Answers{
+		{Int(142), Int(281)},
+		{BigInt(huge), String("24,13,10")},
+		{Int(54), NotApplicable},
+	} {
+		if err := WriteAnswers(path, want); err != nil {
+			t.Fatal(err)
+		}
+		got, err := ReadAnswers(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !want.Part1.Equal(got.Part1) || !want.Part2.Equal(got.Part2) {
+			t.Errorf("want %v, got %v", want, got)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package day
+
+import "sync"
+
+// cache holds the results of Once for the copies of a DayInput.
+type cache struct {
+	mu      sync.Mutex
+	entries map[string]*entry
+}
+
+type entry struct {
+	once  sync.Once
+	value any
+	err   error
+}
+
+func (c *cache) entry(key string) *entry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.entries == nil {
+		c.entries = make(map[string]*entry)
+	}
+	e, ok := c.entries[key]
+	if !ok {
+		e = &entry{}
+		c.entries[key] = e
+	}
+	return e
+}
+
+// Once returns the result of parse, calling it only for the first request of
+// key on d, or on a copy of d. This lets both parts of a Day share a single
+// parsed representation of the input, which they therefore must not modify.
+// Different parses of the same input need different keys. An input created
+// without NewInput does not cache at all.
+func Once[T any](d DayInput, key string, parse func() (T, error)) (T, error) {
+	if d.cache == nil {
+		return parse()
+	}
+
+	e := d.cache.entry(key)
+	e.once.Do(func() {
+		e.value, e.err = parse()
+	})
+	if e.err != nil {
+		var zero T
+		return zero, e.err
+	}
+	return e.value.(T), nil
+}
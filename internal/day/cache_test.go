@@ -0,0 +1,38 @@
+package day
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestOnce(t *testing.T) {
+	t.Parallel()
+	d := writeInput(t, "")
+	copied := d
+
+	calls := 0
+	parse := func() (int, error) {
+		calls++
+		return 42, nil
+	}
+	for _, input := range []DayInput{d, copied} {
+		if got, err := Once(input, "answer", parse); err != nil || got != 42 {
+			t.Errorf("want 42, got %d, %v", got, err)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("want 1 call, got %d", calls)
+	}
+
+	errParse := errors.New("parse failed")
+	for i := 0; i < 2; i++ {
+		if _, err := Once(d, "failing", func() (int, error) { return 0, errParse }); err != errParse {
+			t.Errorf("want %v, got %v", errParse, err)
+		}
+	}
+
+	// different inputs do not share results
+	if _, err := Once(writeInput(t, ""), "answer", parse); err != nil || calls != 2 {
+		t.Errorf("want 2 calls, got %d, %v", calls, err)
+	}
+}
@@ -1,45 +1,181 @@
 package day
 
 import (
-	"bufio"
+	"context"
+	"errors"
+	"flag"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
+	"time"
 )
 
+// Day is implemented by every puzzle solution. Both parts report problems,
+// such as a missing or malformed input file, as an error instead of a zero
+// answer or a panic. A part the puzzle does not have answers NotApplicable.
 type Day interface {
-	ReadLines() ([]string, error)
-	Part1() int
-	Part2() int
+	Part1(ctx context.Context) (Answer, error)
+	Part2(ctx context.Context) (Answer, error)
 }
 
-type DayInput string
+// DayInput is the input file of a Day. Copies of a DayInput share a cache of
+// parsed input, see Once, so that both parts of a Day parse the input once.
+type DayInput struct {
+	path  string
+	cache *cache
+}
+
+func NewInput(path string) DayInput {
+	return DayInput{path, &cache{}}
+}
+
+func (d DayInput) Path() string {
+	return d.path
+}
 
+// ReadLines reads all lines of the input. The lines are read from disk once
+// and shared, so they must not be modified.
 func (d DayInput) ReadLines() ([]string, error) {
-	file, err := os.Open(string(d))
-	if err != nil {
-		return nil, err
+	return Once(d, "lines", func() ([]string, error) {
+		lines, err := d.Lines()
+		if err != nil {
+			return nil, err
+		}
+		defer lines.Close()
+
+		result := make([]string, 0)
+		for lines.Scan() {
+			result = append(result, lines.Text())
+		}
+		return result, lines.Err()
+	})
+}
+
+func (d DayInput) ReadFile() ([]byte, error) {
+	return os.ReadFile(d.path)
+}
+
+// Run solves both parts of p and writes the answers to w. It stops at the
+// first part that fails, or when ctx is done.
+func Run(ctx context.Context, w io.Writer, p Day) error {
+	parts := []func(context.Context) (Answer, error){p.Part1, p.Part2}
+	for i, part := range parts {
+		answer, err := solvePart(ctx, part)
+		if err != nil {
+			return fmt.Errorf("part %d: %w", i+1, err)
+		}
+		fmt.Fprintln(w, answer)
 	}
-	defer file.Close()
+	return nil
+}
 
-	result := make([]string, 0)
-	scanner := bufio.NewScanner(file)
+// solvePart returns the answer of part, or the error of ctx as soon as ctx is
+// done, even when part does not honor ctx itself. Such a part keeps running in
+// the background.
+func solvePart(ctx context.Context, part func(context.Context) (Answer, error)) (Answer, error) {
+	if ctx.Done() == nil {
+		return part(ctx)
+	}
 
-	for scanner.Scan() {
-		result = append(result, scanner.Text())
+	type result struct {
+		answer Answer
+		err    error
 	}
+	done := make(chan result, 1)
+	go func() {
+		answer, err := part(ctx)
+		done <- result{answer, err}
+	}()
 
-	if err := scanner.Err(); err != nil {
-		return nil, err
+	select {
+	case r := <-done:
+		return r.answer, r.err
+	case <-ctx.Done():
+		return Answer{}, ctx.Err()
 	}
+}
 
-	return result, nil
+// ParseFlags parses flags that may appear anywhere between the positional
+// arguments, so that both 'aoc run --all 5' and 'aoc run 5 --all' work. It
+// returns the positional arguments.
+func ParseFlags(fs *flag.FlagSet, args []string) ([]string, error) {
+	positional := make([]string, 0)
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		if fs.NArg() == 0 {
+			return positional, nil
+		}
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
 }
 
-func (d DayInput) ReadFile() ([]byte, error) {
-	return os.ReadFile(string(d))
+// Solve is the main function of the command of a single day. It resolves the
+// input file called name of day n as described at ResolveInput, with --input
+// on the command line as explicit path, builds the Day with newDay and prints
+// the answers of both parts. With --stats it reports the cost of each part
+// instead, as text or, with --stats=json, as JSON; --timeout limits the time
+// to solve both. With --render, --animate and --dot the day also draws its
+// parts, see Visuals. Solve exits with a non-zero exit code when the day fails.
+func Solve(n int, name string, newDay func(inputFile string) Day) {
+	fs := flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+	input := fs.String("input", "", "read the input from this file, or from stdin for -")
+	var format StatsFormat
+	fs.Var(&format, "stats", "report time and memory of each part as text or json")
+	timeout := fs.Duration("timeout", 0, "give up when solving takes longer than this")
+	var visuals Visuals
+	visuals.Flags(fs)
+	if _, err := ParseFlags(fs, os.Args[1:]); err != nil {
+		os.Exit(2)
+	}
+
+	ctx, cancel := WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	file, cleanup, err := ResolveInput(*input, n, name)
+	if err == nil {
+		name := filepath.Base(os.Args[0])
+		p := newDay(file)
+		err = solve(ctx, name, p, format)
+		if err == nil {
+			// keep stats on stdout parseable
+			var w io.Writer = os.Stdout
+			if format != NoStats {
+				w = os.Stderr
+			}
+			err = visuals.Draw(ctx, w, name, p)
+		}
+		cleanup()
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		err = fmt.Errorf("timed out after %v: %w", *timeout, err)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
 }
 
-func Solve(p Day) {
-	fmt.Println(p.Part1())
-	fmt.Println(p.Part2())
+// WithTimeout is context.WithTimeout, except that a timeout of 0 means no
+// timeout at all.
+func WithTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout == 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+func solve(ctx context.Context, name string, p Day, format StatsFormat) error {
+	if format == NoStats {
+		return Run(ctx, os.Stdout, p)
+	}
+
+	stats, err := Measure(ctx, name, p)
+	if err != nil {
+		return err
+	}
+	return WriteStats(os.Stdout, format, []Stats{stats})
 }
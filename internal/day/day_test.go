@@ -0,0 +1,46 @@
+package day
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+)
+
+// stuckDay never finishes part 1 and ignores its context.
+type stuckDay struct {
+	release chan struct{}
+}
+
+func (d stuckDay) Part1(ctx context.Context) (Answer, error) {
+	<-d.release
+	return Int(1), nil
+}
+
+func (d stuckDay) Part2(ctx context.Context) (Answer, error) {
+	return Int(2), nil
+}
+
+func TestRunTimeout(t *testing.T) {
+	t.Parallel()
+	d := stuckDay{make(chan struct{})}
+	defer close(d.release)
+
+	ctx, cancel := WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	err := Run(ctx, io.Discard, d)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("want %v, got %v", context.DeadlineExceeded, err)
+	}
+}
+
+func TestWithTimeout(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := WithTimeout(context.Background(), 0)
+	defer cancel()
+	if _, ok := ctx.Deadline(); ok {
+		t.Error("want no deadline for a timeout of 0")
+	}
+}
@@ -0,0 +1,147 @@
+package daytest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/gen"
+)
+
+// ErrNoBenchInput is returned when a part has neither a real input nor an
+// example to be benchmarked on.
+var ErrNoBenchInput = errors.New("no input to benchmark on")
+
+// benchSeed is the seed of generated benchmark inputs, fixed so that runs
+// can be compared.
+const benchSeed = 1
+
+// BenchInput returns the input file to benchmark part 1 or 2 of s on: the
+// real input when present, else a generated input of real size when the day
+// has a generator, see package gen, or else the largest example with an
+// answer for the part.
+func BenchInput(s day.Solver, part int) (string, error) {
+	if _, err := os.Stat(s.InputFile()); err == nil {
+		return s.InputFile(), nil
+	}
+	if _, ok := gen.Lookup(s.Day); ok {
+		return generated(s)
+	}
+
+	dir := Dir(s.Day)
+	examples, err := Examples(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", fmt.Errorf("%s part %d: %w", s.Name(), part, ErrNoBenchInput)
+	}
+	if err != nil {
+		return "", err
+	}
+	result, size := "", int64(-1)
+	for name, answers := range examples {
+		if _, ok := answers[parts[part-1]]; !ok {
+			continue
+		}
+		file := filepath.Join(dir, name)
+		info, err := os.Stat(file)
+		if err != nil {
+			return "", err
+		}
+		// ties go to the first name, so that the choice is stable
+		if info.Size() > size || info.Size() == size && file < result {
+			result, size = file, info.Size()
+		}
+	}
+	if result == "" {
+		return "", fmt.Errorf("%s part %d: %w", s.Name(), part, ErrNoBenchInput)
+	}
+	return result, nil
+}
+
+// generated writes the generated input of the day of s to the temporary
+// directory and returns its path. The file is replaced atomically, so that
+// benchmarks running at the same time never read a partial input.
+func generated(s day.Solver) (string, error) {
+	p, err := gen.Generate(s.Day, benchSeed, 0)
+	if err != nil {
+		return "", err
+	}
+	dir := filepath.Join(os.TempDir(), "aoc-bench")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+	f, err := os.CreateTemp(dir, s.Name()+"-*.txt")
+	if err != nil {
+		return "", err
+	}
+	_, err = p.WriteTo(f)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	file := filepath.Join(dir, fmt.Sprintf("day%02d.txt", s.Day))
+	if err == nil {
+		err = os.Rename(f.Name(), file)
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+	return file, nil
+}
+
+// Input returns the input file to benchmark part of the only solver
+// registered in the test binary of a day's package on, see BenchInput. The
+// benchmark is skipped when there is none.
+func Input(b *testing.B, part int) string {
+	b.Helper()
+	solvers := day.Solvers()
+	if len(solvers) == 0 {
+		b.Fatal("no registered solvers")
+	}
+	file, err := BenchInput(solvers[0], part)
+	if errors.Is(err, ErrNoBenchInput) {
+		b.Skip(err)
+	}
+	if err != nil {
+		b.Fatal(err)
+	}
+	return file
+}
+
+// BenchmarkParts benchmarks both parts of every registered solver. Every
+// iteration solves the part on a fresh Day, so that parsing the input is
+// included.
+func BenchmarkParts(b *testing.B) {
+	solvers := day.Solvers()
+	if len(solvers) == 0 {
+		b.Fatal("no registered solvers")
+	}
+
+	for _, s := range solvers {
+		for part := 1; part <= 2; part++ {
+			s, part := s, part
+			b.Run(fmt.Sprintf("%s/part%d", s.Name(), part), func(b *testing.B) {
+				file, err := BenchInput(s, part)
+				if errors.Is(err, ErrNoBenchInput) {
+					b.Skip(err)
+				}
+				if err != nil {
+					b.Fatal(err)
+				}
+
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					d := s.New(file)
+					solve := []func(context.Context) (day.Answer, error){d.Part1, d.Part2}[part-1]
+					if _, err := solve(context.Background()); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
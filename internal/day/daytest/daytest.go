@@ -0,0 +1,140 @@
+// Package daytest runs the registered solvers on the example inputs of their
+// puzzles.
+package daytest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"testing"
+
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/projectpath"
+)
+
+// ExamplesFile is the name of the file listing the expected answers for the
+// examples of a day. It lives in the day's directory under cmd, next to the
+// examples, and maps each example file to the answers of its parts:
+//
+//	{
+//		"example.txt": {"part1": 13, "part2": 30},
+//		"example2-part2.txt": {"part2": 71}
+//	}
+//
+// Examples named *-part1.txt or *-part2.txt only apply to that part. A part
+// without an answer is not run for the example; an answer of null expects
+// day.NotApplicable.
+const ExamplesFile = "examples.json"
+
+var (
+	exampleGlob = "example*.txt"
+	partRE      = regexp.MustCompile(`-part([12])\.txt$`)
+	parts       = []string{"part1", "part2"}
+)
+
+// Dir is the directory with the examples of day n.
+func Dir(n int) string {
+	return filepath.Join(projectpath.Root, "cmd", fmt.Sprintf("day%02d", n))
+}
+
+// Examples reads the expected answers for the examples in dir, keyed by file
+// name and part ("part1" or "part2"). It checks that every example file has
+// answers and that only existing files and parts are listed.
+func Examples(dir string) (map[string]map[string]day.Answer, error) {
+	data, err := os.ReadFile(filepath.Join(dir, ExamplesFile))
+	if err != nil {
+		return nil, err
+	}
+	var examples map[string]map[string]day.Answer
+	if err := json.Unmarshal(data, &examples); err != nil {
+		return nil, fmt.Errorf("%s: %w", ExamplesFile, err)
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, exampleGlob))
+	if err != nil {
+		return nil, err
+	}
+	found := make(map[string]bool)
+	for _, file := range files {
+		name := filepath.Base(file)
+		found[name] = true
+		if _, ok := examples[name]; !ok {
+			return nil, fmt.Errorf("%s: no answers for %s", ExamplesFile, name)
+		}
+	}
+
+	for name, answers := range examples {
+		if !found[name] {
+			return nil, fmt.Errorf("%s: %s does not exist", ExamplesFile, name)
+		}
+		m := partRE.FindStringSubmatch(name)
+		for part := range answers {
+			if part != "part1" && part != "part2" {
+				return nil, fmt.Errorf("%s: %s: unknown part %q", ExamplesFile, name, part)
+			}
+			if m != nil && part != "part"+m[1] {
+				return nil, fmt.Errorf("%s: %s is an example for part %s only", ExamplesFile, name, m[1])
+			}
+		}
+	}
+	return examples, nil
+}
+
+// RunExamples runs every registered solver on the examples of its day and
+// compares the answers to the expected ones. Registered in a test binary of a
+// single day's package, these are the solvers of that package.
+func RunExamples(t *testing.T) {
+	t.Helper()
+	solvers := day.Solvers()
+	if len(solvers) == 0 {
+		t.Fatal("no registered solvers")
+	}
+
+	for _, s := range solvers {
+		s := s
+		t.Run(s.Name(), func(t *testing.T) {
+			t.Parallel()
+			runExamples(t, s)
+		})
+	}
+}
+
+func runExamples(t *testing.T, s day.Solver) {
+	dir := Dir(s.Day)
+	examples, err := Examples(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	names := make([]string, 0, len(examples))
+	for name := range examples {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		for i, part := range parts {
+			want, ok := examples[name][part]
+			if !ok {
+				continue
+			}
+			file, i := filepath.Join(dir, name), i
+			t.Run(name+"/"+part, func(t *testing.T) {
+				t.Parallel()
+				d := s.New(file)
+				solve := []func(context.Context) (day.Answer, error){d.Part1, d.Part2}[i]
+				got, err := solve(context.Background())
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !want.Equal(got) {
+					t.Errorf("want %v, got %v", want, got)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package daytest
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"adventofcode23/internal/day"
+)
+
+func writeExamples(t *testing.T, sidecar string, files ...string) string {
+	t.Helper()
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, ExamplesFile), []byte(sidecar), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), nil, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestExamples(t *testing.T) {
+	t.Parallel()
+	dir := writeExamples(t,
+		`{"example.txt": {"part1": 54, "part2": null}, "example2-part2.txt": {"part2": 71}}`,
+		"example.txt", "example2-part2.txt")
+
+	examples, err := Examples(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		file, part string
+		want       day.Answer
+	}{
+		{"example.txt", "part1", day.Int(54)},
+		{"example.txt", "part2", day.NotApplicable},
+		{"example2-part2.txt", "part2", day.Int(71)},
+	}
+	for _, test := range tests {
+		got, ok := examples[test.file][test.part]
+		if !ok || !test.want.Equal(got) {
+			t.Errorf("%s %s: want %v, got %v", test.file, test.part, test.want, got)
+		}
+	}
+	if _, ok := examples["example2-part2.txt"]["part1"]; ok {
+		t.Error("example2-part2.txt: unexpected answer for part1")
+	}
+}
+
+func TestExamplesInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		sidecar string
+		files   []string
+	}{
+		{`{"example.txt": {"part1": 1}}`, []string{"example.txt", "example2.txt"}},
+		{`{"example.txt": {"part1": 1}}`, nil},
+		{`{"example-part2.txt": {"part1": 1}}`, []string{"example-part2.txt"}},
+		{`{"example.txt": {"part3": 1}}`, []string{"example.txt"}},
+		{`{"example.txt": {"part1": 1.5}}`, []string{"example.txt"}},
+	}
+
+	for _, test := range tests {
+		if _, err := Examples(writeExamples(t, test.sidecar, test.files...)); err == nil {
+			t.Errorf("%s with %v: want error, got none", test.sidecar, test.files)
+		}
+	}
+}
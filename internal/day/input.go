@@ -0,0 +1,62 @@
+package day
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"adventofcode23/internal/projectpath"
+)
+
+// InputDirEnv names the environment variable pointing to a directory with
+// puzzle inputs. It is laid out like the cmd directory of the source tree,
+// with the input of day 5 in day05/input.txt.
+const InputDirEnv = "AOC_INPUT_DIR"
+
+// Stdin is the input path that reads the input from standard input.
+const Stdin = "-"
+
+// InputFile is the default location of the input file called name of day n:
+// the day's directory under $AOC_INPUT_DIR when that is set, the day's
+// directory in the source tree otherwise.
+func InputFile(n int, name string) string {
+	dir := fmt.Sprintf("day%02d", n)
+	if root := os.Getenv(InputDirEnv); root != "" {
+		return filepath.Join(root, dir, name)
+	}
+	return filepath.Join(projectpath.Root, "cmd", dir, name)
+}
+
+// ResolveInput returns the input file of day n. An explicit path, typically
+// from the --input flag, takes precedence over the default location described
+// at InputFile. A path of Stdin copies standard input to a temporary file, as
+// each part reads the input on its own; cleanup removes that file again.
+func ResolveInput(path string, n int, name string) (file string, cleanup func(), err error) {
+	switch path {
+	case "":
+		return InputFile(n, name), func() {}, nil
+	case Stdin:
+		return readStdin()
+	default:
+		return path, func() {}, nil
+	}
+}
+
+func readStdin() (string, func(), error) {
+	f, err := os.CreateTemp("", "aoc-input-*.txt")
+	if err != nil {
+		return "", nil, err
+	}
+	cleanup := func() { os.Remove(f.Name()) }
+
+	_, err = io.Copy(f, os.Stdin)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		cleanup()
+		return "", nil, fmt.Errorf("reading input from stdin: %w", err)
+	}
+	return f.Name(), cleanup, nil
+}
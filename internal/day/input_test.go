@@ -0,0 +1,47 @@
+package day
+
+import (
+	"path/filepath"
+	"testing"
+
+	"adventofcode23/internal/projectpath"
+)
+
+func TestInputFile(t *testing.T) {
+	t.Setenv(InputDirEnv, "")
+	want := filepath.Join(projectpath.Root, "cmd", "day05", "input.txt")
+	if got := InputFile(5, "input.txt"); want != got {
+		t.Errorf("want %s, got %s", want, got)
+	}
+
+	dir := t.TempDir()
+	t.Setenv(InputDirEnv, dir)
+	want = filepath.Join(dir, "day09", "input")
+	if got := InputFile(9, "input"); want != got {
+		t.Errorf("want %s, got %s", want, got)
+	}
+}
+
+func TestResolveInput(t *testing.T) {
+	t.Setenv(InputDirEnv, t.TempDir())
+
+	want := filepath.Join("testdata", "day05.txt")
+	got, cleanup, err := ResolveInput(want, 5, "input.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cleanup()
+	if want != got {
+		t.Errorf("want %s, got %s", want, got)
+	}
+
+	want = InputFile(5, "input.txt")
+	got, cleanup, err = ResolveInput("", 5, "input.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cleanup()
+	if want != got {
+		t.Errorf("want %s, got %s", want, got)
+	}
+}
@@ -0,0 +1,200 @@
+package day
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParseError reports malformed puzzle input. Line and Column are 1-based; a
+// zero Line means the error concerns the input as a whole, a zero Column
+// that the position within the line is unknown.
+type ParseError struct {
+	File         string
+	Line, Column int
+	Err          error
+}
+
+func (e *ParseError) Error() string {
+	var b strings.Builder
+	if e.File != "" {
+		b.WriteString(e.File)
+		b.WriteByte(':')
+	}
+	if e.Line > 0 {
+		fmt.Fprintf(&b, "%d:", e.Line)
+	}
+	if e.Line > 0 && e.Column > 0 {
+		fmt.Fprintf(&b, "%d:", e.Column)
+	}
+	if b.Len() > 0 {
+		b.WriteByte(' ')
+	}
+	fmt.Fprint(&b, e.Err)
+	return b.String()
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// Errorf returns a *ParseError for the given column of a line. The line
+// number is added by Locate, the file by Parse.
+func Errorf(column int, format string, a ...any) error {
+	return &ParseError{Column: column, Err: fmt.Errorf(format, a...)}
+}
+
+// Locate positions err at line, unless it already has a line. Errors that are
+// not a *ParseError yet are wrapped in one. A line of 0 attributes err to the
+// input as a whole.
+func Locate(err error, line int) error {
+	var pe *ParseError
+	if !errors.As(err, &pe) {
+		return &ParseError{Line: line, Err: err}
+	}
+	if pe.Line == 0 {
+		pe.Line = line
+	}
+	return err
+}
+
+// Parse reads the input and hands its lines to parse. A *ParseError returned
+// by parse is attributed to the input file. When ctx carries a stats recorder,
+// the time and memory spent here are recorded as parsing.
+func Parse[T any](ctx context.Context, d DayInput, parse func(lines []string) (T, error)) (T, error) {
+	defer recordParse(ctx)()
+
+	lines, err := d.ReadLines()
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+
+	result, err := parse(lines)
+	return result, d.attribute(err)
+}
+
+// attribute sets the file of err to the input, if err is a *ParseError
+// without a file.
+func (d DayInput) attribute(err error) error {
+	var pe *ParseError
+	if errors.As(err, &pe) && pe.File == "" {
+		pe.File = d.path
+	}
+	return err
+}
+
+// ParseLines reads the input and parses every line with parse, reporting the
+// first failure at the offending line.
+func ParseLines[T any](ctx context.Context, d DayInput, parse func(line string) (T, error)) ([]T, error) {
+	return Parse(ctx, d, func(lines []string) ([]T, error) {
+		result := make([]T, len(lines))
+		for i, line := range lines {
+			v, err := parse(line)
+			if err != nil {
+				return nil, Locate(err, i+1)
+			}
+			result[i] = v
+		}
+		return result, nil
+	})
+}
+
+// ParseBlocks reads the input in blocks separated by empty lines and parses
+// every block with parse. Line numbers of errors returned by parse count from
+// the first line of the block.
+func ParseBlocks[T any](ctx context.Context, d DayInput, parse func(block []string) (T, error)) ([]T, error) {
+	defer recordParse(ctx)()
+
+	blocks, err := d.Blocks()
+	if err != nil {
+		return nil, err
+	}
+	defer blocks.Close()
+
+	result := make([]T, 0)
+	for blocks.Scan() {
+		v, err := parse(blocks.Block())
+		if err != nil {
+			var pe *ParseError
+			if errors.As(err, &pe) && pe.Line > 0 {
+				pe.Line += blocks.Line() - 1
+			}
+			return nil, d.attribute(Locate(err, blocks.Line()))
+		}
+		result = append(result, v)
+	}
+	return result, blocks.Err()
+}
+
+// Atoi converts s, found at column of its line, to an int.
+func Atoi(s string, column int) (int, error) {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, Errorf(column, "invalid number %q", s)
+	}
+	return n, nil
+}
+
+// Ints parses the whitespace separated numbers in s, which starts at column
+// of its line.
+func Ints(s string, column int) ([]int, error) {
+	result := make([]int, 0)
+	for i := 0; i < len(s); {
+		if s[i] == ' ' || s[i] == '\t' {
+			i++
+			continue
+		}
+		j := i
+		for j < len(s) && s[j] != ' ' && s[j] != '\t' {
+			j++
+		}
+		n, err := Atoi(s[i:j], column+i)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, n)
+		i = j
+	}
+	return result, nil
+}
+
+// SplitInts parses the numbers in s separated by sep, where s starts at column
+// of its line. Spaces around the numbers are ignored.
+func SplitInts(s, sep string, column int) ([]int, error) {
+	fields := strings.Split(s, sep)
+	result := make([]int, len(fields))
+	for i, field := range fields {
+		trimmed := strings.TrimLeft(field, " ")
+		n, err := Atoi(strings.TrimRight(trimmed, " "), column+len(field)-len(trimmed))
+		if err != nil {
+			return nil, err
+		}
+		result[i] = n
+		column += len(field) + len(sep)
+	}
+	return result, nil
+}
+
+// ReadGrid reads a non-empty, rectangular grid of characters. Unless valid is
+// empty, every character in the grid must be one of the characters in valid.
+// The grid is read once and shared by both parts.
+func (d DayInput) ReadGrid(ctx context.Context, valid string) ([]string, error) {
+	return Once(d, "grid "+valid, func() ([]string, error) {
+		return Parse(ctx, d, func(lines []string) ([]string, error) {
+			if len(lines) == 0 || len(lines[0]) == 0 {
+				return nil, Locate(errors.New("empty grid"), 1)
+			}
+
+			for i, line := range lines {
+				if err := checkRow([]byte(line), len(lines[0]), valid, i+1); err != nil {
+					return nil, err
+				}
+			}
+
+			return lines, nil
+		})
+	})
+}
@@ -0,0 +1,114 @@
+package day
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func writeInput(t *testing.T, content string) DayInput {
+	t.Helper()
+	name := filepath.Join(t.TempDir(), "input.txt")
+	if err := os.WriteFile(name, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return NewInput(name)
+}
+
+func TestParseLines(t *testing.T) {
+	t.Parallel()
+	d := writeInput(t, "1 2 3\n4 5 6\n")
+
+	want := [][]int{{1, 2, 3}, {4, 5, 6}}
+	got, err := ParseLines(context.Background(), d, func(line string) ([]int, error) {
+		return Ints(line, 1)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.EqualFunc(want, got, slices.Equal[[]int]) {
+		t.Errorf("want %v, got %v", want, got)
+	}
+}
+
+func TestParseLinesError(t *testing.T) {
+	t.Parallel()
+	d := writeInput(t, "1 2 3\n4 x 6\n")
+
+	_, err := ParseLines(context.Background(), d, func(line string) ([]int, error) {
+		return Ints(line, 1)
+	})
+
+	var pe *ParseError
+	if !errors.As(err, &pe) {
+		t.Fatalf("want *ParseError, got %v", err)
+	}
+	want := ParseError{File: d.Path(), Line: 2, Column: 3}
+	got := ParseError{File: pe.File, Line: pe.Line, Column: pe.Column}
+	if want != got {
+		t.Errorf("want %+v, got %+v", want, got)
+	}
+
+	wantMsg := d.Path() + `:2:3: invalid number "x"`
+	if gotMsg := err.Error(); wantMsg != gotMsg {
+		t.Errorf("want %q, got %q", wantMsg, gotMsg)
+	}
+}
+
+func TestMissingInput(t *testing.T) {
+	t.Parallel()
+	d := NewInput(filepath.Join(t.TempDir(), "input.txt"))
+
+	_, err := ParseLines(context.Background(), d, func(line string) (string, error) {
+		return line, nil
+	})
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("want %v, got %v", os.ErrNotExist, err)
+	}
+}
+
+func TestSplitInts(t *testing.T) {
+	t.Parallel()
+
+	want := []int{19, -2, 1}
+	got, err := SplitInts("19, -2,  1", ",", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(want, got) {
+		t.Errorf("want %v, got %v", want, got)
+	}
+
+	_, err = SplitInts("19, -2,  a", ",", 5)
+	var pe *ParseError
+	if !errors.As(err, &pe) || pe.Column != 14 {
+		t.Errorf("want error at column 14, got %v", err)
+	}
+}
+
+func TestReadGrid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		content      string
+		line, column int
+	}{
+		{"", 1, 0},
+		{"..#\n.#\n", 2, 0},
+		{"..#\n.x.\n", 2, 2},
+	}
+
+	for _, test := range tests {
+		_, err := writeInput(t, test.content).ReadGrid(context.Background(), ".#")
+		var pe *ParseError
+		if !errors.As(err, &pe) {
+			t.Fatalf("%q: want *ParseError, got %v", test.content, err)
+		}
+		if pe.Line != test.line || pe.Column != test.column {
+			t.Errorf("%q: want %d:%d, got %d:%d", test.content, test.line, test.column, pe.Line, pe.Column)
+		}
+	}
+}
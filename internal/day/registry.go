@@ -0,0 +1,74 @@
+package day
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Solver is a registered implementation of a puzzle. Every day has a primary
+// implementation with an empty Variant; alternate implementations of the same
+// day (day05b, day14b, ...) register with a non-empty Variant.
+type Solver struct {
+	Day     int
+	Variant string
+	New     func(inputFile string) Day
+}
+
+var registry = make(map[string]Solver)
+
+func (s Solver) Name() string {
+	return fmt.Sprintf("day%02d%s", s.Day, s.Variant)
+}
+
+// InputFile is the default location of the puzzle input, shared by all
+// variants of a day.
+func (s Solver) InputFile() string {
+	return InputFile(s.Day, "input.txt")
+}
+
+func (s Solver) IsVariant() bool {
+	return s.Variant != ""
+}
+
+// Register adds a solver to the registry. It is meant to be called from the
+// init function of the package implementing the solver, and panics when a
+// solver with the same name was registered before.
+func Register(s Solver) {
+	name := s.Name()
+	if _, ok := registry[name]; ok {
+		panic(fmt.Sprintf("day: solver %s registered twice", name))
+	}
+	registry[name] = s
+}
+
+func Lookup(name string) (Solver, bool) {
+	s, ok := registry[name]
+	return s, ok
+}
+
+// Solvers returns all registered solvers, ordered by day, with the primary
+// implementation of a day before its variants.
+func Solvers() []Solver {
+	result := make([]Solver, 0, len(registry))
+	for _, s := range registry {
+		result = append(result, s)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Day != result[j].Day {
+			return result[i].Day < result[j].Day
+		}
+		return result[i].Variant < result[j].Variant
+	})
+	return result
+}
+
+// Variants returns all registered solvers for day n, primary first.
+func Variants(n int) []Solver {
+	result := make([]Solver, 0)
+	for _, s := range Solvers() {
+		if s.Day == n {
+			result = append(result, s)
+		}
+	}
+	return result
+}
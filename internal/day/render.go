@@ -0,0 +1,231 @@
+package day
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"adventofcode23/internal/dot"
+	"adventofcode23/internal/render"
+)
+
+// ErrCannotRender is returned when asked to render a day that cannot draw
+// its parts.
+var ErrCannotRender = errors.New("day cannot render its parts")
+
+// Renderer is implemented by days that can draw what their parts compute.
+type Renderer interface {
+	// Render draws what part 1 or 2 computes, or returns a nil picture when
+	// the part has nothing to show.
+	Render(ctx context.Context, part int) (*render.Picture, error)
+}
+
+// RenderParts draws both parts of p in format. ANSI pictures are written to
+// w, the others to files in dir named after the day and part, such as
+// day10-part2.png; w then lists the files written.
+func RenderParts(ctx context.Context, w io.Writer, dir, name string, p Day, format render.Format) error {
+	r, ok := p.(Renderer)
+	if !ok {
+		return ErrCannotRender
+	}
+
+	for part := 1; part <= 2; part++ {
+		picture, err := r.Render(ctx, part)
+		if err != nil {
+			return fmt.Errorf("part %d: %w", part, err)
+		}
+		if picture == nil {
+			continue
+		}
+
+		if format == render.ANSI {
+			if err := picture.Encode(w, format); err != nil {
+				return err
+			}
+			continue
+		}
+
+		file := filepath.Join(dir, fmt.Sprintf("%s-part%d%s", name, part, format.Ext()))
+		if err := writePicture(file, picture, format); err != nil {
+			return err
+		}
+		fmt.Fprintln(w, file)
+	}
+	return nil
+}
+
+func writePicture(file string, picture *render.Picture, format render.Format) error {
+	f, err := os.Create(file)
+	if err != nil {
+		return err
+	}
+	if err := picture.Encode(f, format); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// ErrCannotAnimate is returned when asked to animate a day without
+// simulations to watch.
+var ErrCannotAnimate = errors.New("day cannot animate its parts")
+
+// Animator is implemented by days that simulate something step by step.
+type Animator interface {
+	// Animate runs the simulation of part 1 or 2, calling record with a
+	// picture after every step. It records nothing when the part has
+	// nothing to show.
+	Animate(ctx context.Context, part int, record func(*render.Picture)) error
+}
+
+// AnimateParts records the simulations of both parts of p and shows every
+// frame for delay. GIFs are written to files in dir named after the day and
+// part, such as day14-part1.gif, which are listed on w; terminal frames are
+// played on w.
+func AnimateParts(ctx context.Context, w io.Writer, dir, name string, p Day, format render.MovieFormat, delay time.Duration) error {
+	a, ok := p.(Animator)
+	if !ok {
+		return ErrCannotAnimate
+	}
+
+	for part := 1; part <= 2; part++ {
+		movie := render.NewMovie()
+		if err := a.Animate(ctx, part, movie.Record); err != nil {
+			return fmt.Errorf("part %d: %w", part, err)
+		}
+		if len(movie.Frames()) == 0 {
+			continue
+		}
+
+		if format == render.Terminal {
+			if err := movie.Encode(ctx, w, format, delay); err != nil {
+				return err
+			}
+			continue
+		}
+
+		file := filepath.Join(dir, fmt.Sprintf("%s-part%d.%s", name, part, format))
+		if err := writeMovie(ctx, file, movie, format, delay); err != nil {
+			return err
+		}
+		fmt.Fprintln(w, file)
+	}
+	return nil
+}
+
+func writeMovie(ctx context.Context, file string, movie *render.Movie, format render.MovieFormat, delay time.Duration) error {
+	f, err := os.Create(file)
+	if err != nil {
+		return err
+	}
+	if err := movie.Encode(ctx, f, format, delay); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// ErrCannotGraph is returned when asked for the graphs of a day whose input
+// is not a graph.
+var ErrCannotGraph = errors.New("day has no graph to export")
+
+// Grapher is implemented by days whose input is a graph.
+type Grapher interface {
+	// Dot returns the graph as part 1 or 2 sees it, with what the part
+	// finds highlighted, or nil when the part has nothing to show.
+	Dot(ctx context.Context, part int) (*dot.Graph, error)
+}
+
+// GraphParts writes the graphs of both parts of p in the DOT language to
+// files in dir named after the day and part, such as day25-part1.dot, and
+// lists them on w.
+func GraphParts(ctx context.Context, w io.Writer, dir, name string, p Day) error {
+	g, ok := p.(Grapher)
+	if !ok {
+		return ErrCannotGraph
+	}
+
+	for part := 1; part <= 2; part++ {
+		graph, err := g.Dot(ctx, part)
+		if err != nil {
+			return fmt.Errorf("part %d: %w", part, err)
+		}
+		if graph == nil {
+			continue
+		}
+
+		file := filepath.Join(dir, fmt.Sprintf("%s-part%d.dot", name, part))
+		if err := writeGraph(file, graph); err != nil {
+			return err
+		}
+		fmt.Fprintln(w, file)
+	}
+	return nil
+}
+
+func writeGraph(file string, graph *dot.Graph) error {
+	f, err := os.Create(file)
+	if err != nil {
+		return err
+	}
+	if _, err := graph.WriteTo(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// Visuals selects what is drawn after a day is solved.
+type Visuals struct {
+	Picture render.Format
+	Movie   render.MovieFormat
+	FPS     int
+	Dot     bool
+}
+
+// Flags defines --render, --animate, --fps and --dot on fs.
+func (v *Visuals) Flags(fs *flag.FlagSet) {
+	fs.Var(&v.Picture, "render", "draw the parts as png, svg or ansi")
+	fs.Var(&v.Movie, "animate", "animate the simulations of the parts as gif or ansi")
+	fs.IntVar(&v.FPS, "fps", 10, "frames per second of animations")
+	fs.BoolVar(&v.Dot, "dot", false, "write the graphs of the parts as Graphviz DOT files")
+}
+
+// Draw renders, animates and exports the graphs of the parts of p as
+// selected. Pictures and file names go to w, files to the working directory.
+// When p cannot draw what is selected, Draw goes on with the rest and returns
+// ErrCannotRender, ErrCannotAnimate or ErrCannotGraph at the end.
+func (v Visuals) Draw(ctx context.Context, w io.Writer, name string, p Day) error {
+	var unsupported []error
+	if v.Picture != render.NoRender {
+		err := RenderParts(ctx, w, ".", name, p, v.Picture)
+		if errors.Is(err, ErrCannotRender) {
+			unsupported = append(unsupported, err)
+		} else if err != nil {
+			return err
+		}
+	}
+	if v.Movie != render.NoMovie {
+		delay := time.Second / time.Duration(max(v.FPS, 1))
+		err := AnimateParts(ctx, w, ".", name, p, v.Movie, delay)
+		if errors.Is(err, ErrCannotAnimate) {
+			unsupported = append(unsupported, err)
+		} else if err != nil {
+			return err
+		}
+	}
+	if v.Dot {
+		err := GraphParts(ctx, w, ".", name, p)
+		if errors.Is(err, ErrCannotGraph) {
+			unsupported = append(unsupported, err)
+		} else if err != nil {
+			return err
+		}
+	}
+	return errors.Join(unsupported...)
+}
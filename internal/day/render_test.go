@@ -0,0 +1,135 @@
+package day
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"adventofcode23/internal/dot"
+	"adventofcode23/internal/render"
+)
+
+// drawingDay only draws part 2.
+type drawingDay struct {
+	stuckDay
+}
+
+func (d drawingDay) Render(ctx context.Context, part int) (*render.Picture, error) {
+	if part == 1 {
+		return nil, nil
+	}
+	return render.FromText([][]byte{[]byte("#.")}), nil
+}
+
+func TestRenderParts(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	ctx := context.Background()
+
+	var w strings.Builder
+	if err := RenderParts(ctx, &w, dir, "day99", drawingDay{}, render.SVG); err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Join(dir, "day99-part2.svg")
+	if got := w.String(); got != file+"\n" {
+		t.Errorf("want %q listed, got %q", file, got)
+	}
+	if _, err := os.Stat(file); err != nil {
+		t.Error(err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "day99-part1.svg")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("want no picture of part 1, got %v", err)
+	}
+
+	w.Reset()
+	if err := RenderParts(ctx, &w, dir, "day99", drawingDay{}, render.ANSI); err != nil {
+		t.Fatal(err)
+	}
+	if got := w.String(); got != "#.\n" {
+		t.Errorf("want the picture on w, got %q", got)
+	}
+
+	err := RenderParts(ctx, &w, dir, "day99", stuckDay{}, render.PNG)
+	if !errors.Is(err, ErrCannotRender) {
+		t.Errorf("want %v, got %v", ErrCannotRender, err)
+	}
+}
+
+// Animate records two frames of part 1.
+func (d drawingDay) Animate(ctx context.Context, part int, record func(*render.Picture)) error {
+	if part == 2 {
+		return nil
+	}
+	p := render.New(1, 1)
+	record(p)
+	p.Set(0, 0, render.Cell{Glyph: '#'})
+	record(p)
+	return nil
+}
+
+func TestAnimateParts(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	ctx := context.Background()
+
+	var w strings.Builder
+	if err := AnimateParts(ctx, &w, dir, "day99", drawingDay{}, render.GIF, time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Join(dir, "day99-part1.gif")
+	if got := w.String(); got != file+"\n" {
+		t.Errorf("want %q listed, got %q", file, got)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "day99-part2.gif")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("want no animation of part 2, got %v", err)
+	}
+
+	err := AnimateParts(ctx, &w, dir, "day99", stuckDay{}, render.Terminal, time.Millisecond)
+	if !errors.Is(err, ErrCannotAnimate) {
+		t.Errorf("want %v, got %v", ErrCannotAnimate, err)
+	}
+}
+
+// Dot only has a graph of part 1.
+func (d drawingDay) Dot(ctx context.Context, part int) (*dot.Graph, error) {
+	if part == 2 {
+		return nil, nil
+	}
+	g := dot.New("day99", true)
+	g.Edge("a", "b", nil)
+	return g, nil
+}
+
+func TestGraphParts(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	ctx := context.Background()
+
+	var w strings.Builder
+	if err := GraphParts(ctx, &w, dir, "day99", drawingDay{}); err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Join(dir, "day99-part1.dot")
+	if got := w.String(); got != file+"\n" {
+		t.Errorf("want %q listed, got %q", file, got)
+	}
+	b, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "digraph \"day99\" {\n\t\"a\" -> \"b\";\n}\n"; string(b) != want {
+		t.Errorf("want %q, got %q", want, b)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "day99-part2.dot")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("want no graph of part 2, got %v", err)
+	}
+
+	err = GraphParts(ctx, &w, dir, "day99", stuckDay{})
+	if !errors.Is(err, ErrCannotGraph) {
+		t.Errorf("want %v, got %v", ErrCannotGraph, err)
+	}
+}
@@ -0,0 +1,234 @@
+package day
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"runtime"
+	"runtime/metrics"
+	"strings"
+	"sync"
+	"time"
+)
+
+// heapSampleInterval is how often the heap is sampled to find its peak.
+const heapSampleInterval = time.Millisecond
+
+const heapMetric = "/memory/classes/heap/objects:bytes"
+
+// Measurement is the cost of a piece of work. Allocations are counted for the
+// whole process, so work running concurrently is included.
+type Measurement struct {
+	Wall     time.Duration `json:"wall_ns"`
+	Allocs   uint64        `json:"allocs"`
+	Bytes    uint64        `json:"bytes"`
+	PeakHeap uint64        `json:"peak_heap_bytes"`
+}
+
+func (m *Measurement) add(o Measurement) {
+	m.Wall += o.Wall
+	m.Allocs += o.Allocs
+	m.Bytes += o.Bytes
+	m.PeakHeap = max(m.PeakHeap, o.PeakHeap)
+}
+
+// PartStats is the answer to one part along with its cost. Total includes
+// Parse, the share of the part spent reading and parsing the input.
+type PartStats struct {
+	Part   int         `json:"part"`
+	Answer string      `json:"answer"`
+	Total  Measurement `json:"total"`
+	Parse  Measurement `json:"parse"`
+}
+
+// Stats is the result of measuring both parts of a Day.
+type Stats struct {
+	Name  string      `json:"name"`
+	Parts []PartStats `json:"parts"`
+}
+
+type statsKey struct{}
+
+type parseRecorder struct {
+	mu sync.Mutex
+	m  Measurement
+}
+
+// recordParse starts measuring a parse for the recorder in ctx, if any. The
+// returned function stops the measurement.
+func recordParse(ctx context.Context) func() {
+	r, ok := ctx.Value(statsKey{}).(*parseRecorder)
+	if !ok {
+		return func() {}
+	}
+
+	stop := startMeasurement()
+	return func() {
+		m := stop()
+		r.mu.Lock()
+		r.m.add(m)
+		r.mu.Unlock()
+	}
+}
+
+// startMeasurement measures the work done until the returned function is
+// called.
+func startMeasurement() func() Measurement {
+	var before runtime.MemStats
+	runtime.ReadMemStats(&before)
+
+	done := make(chan struct{})
+	peak := make(chan uint64)
+	go func() {
+		sample := []metrics.Sample{{Name: heapMetric}}
+		result := before.HeapAlloc
+		ticker := time.NewTicker(heapSampleInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				metrics.Read(sample)
+				if sample[0].Value.Kind() == metrics.KindUint64 {
+					result = max(result, sample[0].Value.Uint64())
+				}
+			case <-done:
+				peak <- result
+				return
+			}
+		}
+	}()
+
+	start := time.Now()
+	return func() Measurement {
+		wall := time.Since(start)
+		close(done)
+		p := <-peak
+
+		var after runtime.MemStats
+		runtime.ReadMemStats(&after)
+		return Measurement{
+			Wall:     wall,
+			Allocs:   after.Mallocs - before.Mallocs,
+			Bytes:    after.TotalAlloc - before.TotalAlloc,
+			PeakHeap: max(p, after.HeapAlloc),
+		}
+	}
+}
+
+// Measure solves both parts of p like Run, recording the cost of each part
+// and of the input parsing within it, see MeasurePart.
+func Measure(ctx context.Context, name string, p Day) (Stats, error) {
+	stats := Stats{Name: name}
+	for part := 1; part <= 2; part++ {
+		ps, err := MeasurePart(ctx, p, part)
+		if err != nil {
+			return stats, fmt.Errorf("part %d: %w", part, err)
+		}
+		stats.Parts = append(stats.Parts, ps)
+	}
+	return stats, nil
+}
+
+// MeasurePart solves part 1 or 2 of p, recording its cost and that of the
+// input parsing within it. The heap is collected first, so that garbage left
+// by earlier work does not count towards the peak.
+func MeasurePart(ctx context.Context, p Day, part int) (PartStats, error) {
+	solve := []func(context.Context) (Answer, error){p.Part1, p.Part2}[part-1]
+	r := &parseRecorder{}
+	runtime.GC()
+
+	stop := startMeasurement()
+	answer, err := solvePart(context.WithValue(ctx, statsKey{}, r), solve)
+	total := stop()
+	if err != nil {
+		return PartStats{}, err
+	}
+	return PartStats{
+		Part:   part,
+		Answer: answer.String(),
+		Total:  total,
+		Parse:  r.m,
+	}, nil
+}
+
+// WriteText writes s as a table meant for humans.
+func (s Stats) WriteText(w io.Writer) error {
+	var b strings.Builder
+	fmt.Fprintln(&b, s.Name)
+	for _, p := range s.Parts {
+		fmt.Fprintf(&b, "  part %d: %s\n", p.Part, p.Answer)
+		writeMeasurement(&b, "total", p.Total)
+		writeMeasurement(&b, "parse", p.Parse)
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func writeMeasurement(w io.Writer, label string, m Measurement) {
+	fmt.Fprintf(w, "    %-5s %12v %10d allocs %10s alloc %10s peak heap\n",
+		label, m.Wall.Round(time.Microsecond), m.Allocs, FormatBytes(m.Bytes), FormatBytes(m.PeakHeap))
+}
+
+// WriteStats writes stats in the given format. JSON is written as a single
+// array with one element per Day.
+func WriteStats(w io.Writer, format StatsFormat, stats []Stats) error {
+	if format == JSONStats {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(stats)
+	}
+
+	for _, s := range stats {
+		if err := s.WriteText(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FormatBytes formats n with a binary unit, such as 1.5 MiB.
+func FormatBytes(n uint64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := uint64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
+// StatsFormat is a flag selecting whether and how stats are reported. Given
+// without a value, as in --stats, it selects text.
+type StatsFormat string
+
+const (
+	NoStats   StatsFormat = ""
+	TextStats StatsFormat = "text"
+	JSONStats StatsFormat = "json"
+)
+
+func (f *StatsFormat) String() string {
+	return string(*f)
+}
+
+func (f *StatsFormat) Set(s string) error {
+	switch StatsFormat(s) {
+	case TextStats, JSONStats:
+		*f = StatsFormat(s)
+	case "true":
+		*f = TextStats
+	case "false":
+		*f = NoStats
+	default:
+		return fmt.Errorf("unknown stats format %q, want text or json", s)
+	}
+	return nil
+}
+
+func (f *StatsFormat) IsBoolFlag() bool {
+	return true
+}
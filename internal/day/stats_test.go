@@ -0,0 +1,120 @@
+package day
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"strings"
+	"testing"
+)
+
+type sumDay struct {
+	DayInput
+}
+
+func (d sumDay) sum(ctx context.Context) (int, error) {
+	rows, err := ParseLines(ctx, d.DayInput, func(line string) ([]int, error) {
+		return Ints(line, 1)
+	})
+	if err != nil {
+		return 0, err
+	}
+	sum := 0
+	for _, row := range rows {
+		for _, n := range row {
+			sum += n
+		}
+	}
+	return sum, nil
+}
+
+func (d sumDay) Part1(ctx context.Context) (Answer, error) {
+	sum, err := d.sum(ctx)
+	if err != nil {
+		return Answer{}, err
+	}
+	return Int(sum), nil
+}
+
+func (d sumDay) Part2(ctx context.Context) (Answer, error) {
+	return NotApplicable, nil
+}
+
+func TestMeasure(t *testing.T) {
+	t.Parallel()
+	d := sumDay{writeInput(t, "1 2 3\n4 5 6\n")}
+
+	stats, err := Measure(context.Background(), "sum", d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stats.Parts) != 2 {
+		t.Fatalf("want 2 parts, got %d", len(stats.Parts))
+	}
+
+	p1, p2 := stats.Parts[0], stats.Parts[1]
+	if p1.Answer != "21" || p2.Answer != "n/a" {
+		t.Errorf("want answers 21 and n/a, got %s and %s", p1.Answer, p2.Answer)
+	}
+	if p1.Parse.Wall == 0 || p1.Parse.Allocs == 0 {
+		t.Errorf("want parse of part 1 measured, got %+v", p1.Parse)
+	}
+	if p1.Parse.Wall > p1.Total.Wall {
+		t.Errorf("parse took %v, longer than the part's %v", p1.Parse.Wall, p1.Total.Wall)
+	}
+	if p2.Parse != (Measurement{}) {
+		t.Errorf("want no parse for part 2, got %+v", p2.Parse)
+	}
+}
+
+func TestWriteStats(t *testing.T) {
+	t.Parallel()
+	stats := []Stats{{Name: "day01", Parts: []PartStats{{Part: 1, Answer: "142"}}}}
+
+	var text bytes.Buffer
+	if err := WriteStats(&text, TextStats, stats); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(text.String(), "day01\n  part 1: 142\n") {
+		t.Errorf("unexpected text output %q", text.String())
+	}
+
+	var js bytes.Buffer
+	if err := WriteStats(&js, JSONStats, stats); err != nil {
+		t.Fatal(err)
+	}
+	var got []Stats
+	if err := json.Unmarshal(js.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0].Name != "day01" || got[0].Parts[0].Answer != "142" {
+		t.Errorf("unexpected JSON output %s", js.String())
+	}
+}
+
+func TestStatsFlag(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		args []string
+		want StatsFormat
+	}{
+		{nil, NoStats},
+		{[]string{"--stats"}, TextStats},
+		{[]string{"--stats=json"}, JSONStats},
+		{[]string{"--stats", "input.txt"}, TextStats},
+	}
+
+	for _, test := range tests {
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		var got StatsFormat
+		fs.Var(&got, "stats", "")
+		if _, err := ParseFlags(fs, test.args); err != nil {
+			t.Fatal(err)
+		}
+		if test.want != got {
+			t.Errorf("%v: want %q, got %q", test.args, test.want, got)
+		}
+	}
+}
@@ -0,0 +1,191 @@
+package day
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// maxLineLength is the longest line the scanners accept. Some puzzles, like
+// day 15, put the whole input on a single line.
+const maxLineLength = 1 << 20
+
+// LineScanner reads an input line by line without loading it as a whole. Its
+// use follows bufio.Scanner:
+//
+//	lines, err := d.Lines()
+//	if err != nil {
+//		return err
+//	}
+//	defer lines.Close()
+//	for lines.Scan() {
+//		... lines.Text() ...
+//	}
+//	if err := lines.Err(); err != nil {
+//		return err
+//	}
+type LineScanner struct {
+	file    *os.File
+	scanner *bufio.Scanner
+	line    int
+}
+
+// Lines returns a scanner over the lines of the input, which must be closed
+// after use.
+func (d DayInput) Lines() (*LineScanner, error) {
+	file, err := os.Open(d.path)
+	if err != nil {
+		return nil, err
+	}
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(nil, maxLineLength)
+	return &LineScanner{file: file, scanner: scanner}, nil
+}
+
+// Scan advances to the next line, reporting whether there is one.
+func (s *LineScanner) Scan() bool {
+	if !s.scanner.Scan() {
+		return false
+	}
+	s.line++
+	return true
+}
+
+// Text returns the current line, without line ending.
+func (s *LineScanner) Text() string {
+	return s.scanner.Text()
+}
+
+// Bytes returns the current line. The slice is only valid until the next
+// call to Scan.
+func (s *LineScanner) Bytes() []byte {
+	return s.scanner.Bytes()
+}
+
+// Line returns the 1-based number of the current line.
+func (s *LineScanner) Line() int {
+	return s.line
+}
+
+func (s *LineScanner) Err() error {
+	return s.scanner.Err()
+}
+
+func (s *LineScanner) Close() error {
+	return s.file.Close()
+}
+
+// BlockScanner reads an input in blocks of lines separated by one or more
+// empty lines, like the patterns of day 13. It is used like a LineScanner.
+type BlockScanner struct {
+	lines *LineScanner
+	block []string
+	start int
+}
+
+// Blocks returns a scanner over the blocks of the input, which must be closed
+// after use.
+func (d DayInput) Blocks() (*BlockScanner, error) {
+	lines, err := d.Lines()
+	if err != nil {
+		return nil, err
+	}
+	return &BlockScanner{lines: lines}, nil
+}
+
+// Scan advances to the next block, reporting whether there is one.
+func (s *BlockScanner) Scan() bool {
+	s.block = nil
+	for s.lines.Scan() {
+		line := s.lines.Text()
+		if line == "" {
+			if len(s.block) > 0 {
+				return true
+			}
+			continue
+		}
+		if len(s.block) == 0 {
+			s.start = s.lines.Line()
+		}
+		s.block = append(s.block, line)
+	}
+	return len(s.block) > 0
+}
+
+// Block returns the lines of the current block.
+func (s *BlockScanner) Block() []string {
+	return s.block
+}
+
+// Line returns the 1-based line number of the first line of the current
+// block.
+func (s *BlockScanner) Line() int {
+	return s.start
+}
+
+func (s *BlockScanner) Err() error {
+	return s.lines.Err()
+}
+
+func (s *BlockScanner) Close() error {
+	return s.lines.Close()
+}
+
+// checkRow checks that row has width characters that all appear in valid,
+// unless valid is empty.
+func checkRow(row []byte, width int, valid string, line int) error {
+	if len(row) != width {
+		return Locate(fmt.Errorf("row has length %d, want %d", len(row), width), line)
+	}
+	if valid == "" {
+		return nil
+	}
+	for i, c := range row {
+		if strings.IndexByte(valid, c) == -1 {
+			return Locate(Errorf(i+1, "unexpected character %q", c), line)
+		}
+	}
+	return nil
+}
+
+// ReadByteGrid reads a non-empty, rectangular grid of characters as rows of
+// bytes, without going through a string per row. Unless valid is empty, every
+// character in the grid must be one of the characters in valid. Unlike
+// ReadGrid, the grid is read anew on every call, so the caller may modify it.
+func (d DayInput) ReadByteGrid(ctx context.Context, valid string) ([][]byte, error) {
+	defer recordParse(ctx)()
+
+	lines, err := d.Lines()
+	if err != nil {
+		return nil, err
+	}
+	defer lines.Close()
+
+	grid := make([][]byte, 0)
+	for lines.Scan() {
+		row := lines.Bytes()
+		if len(grid) == 0 && len(row) == 0 {
+			break
+		}
+		width := len(row)
+		if len(grid) > 0 {
+			width = len(grid[0])
+		}
+		if err := checkRow(row, width, valid, lines.Line()); err != nil {
+			return nil, d.attribute(err)
+		}
+		grid = append(grid, append([]byte(nil), row...))
+	}
+	if err := lines.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(grid) == 0 || len(grid[0]) == 0 {
+		return nil, d.attribute(Locate(errors.New("empty grid"), 1))
+	}
+	return grid, nil
+}
@@ -0,0 +1,126 @@
+package day
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestLines(t *testing.T) {
+	t.Parallel()
+	d := writeInput(t, "a\n\nbc\n")
+
+	lines, err := d.Lines()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lines.Close()
+
+	want := []string{"a", "", "bc"}
+	got := make([]string, 0)
+	for lines.Scan() {
+		if lines.Line() != len(got)+1 {
+			t.Errorf("want line %d, got %d", len(got)+1, lines.Line())
+		}
+		got = append(got, lines.Text())
+	}
+	if err := lines.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(want, got) {
+		t.Errorf("want %q, got %q", want, got)
+	}
+}
+
+func TestBlocks(t *testing.T) {
+	t.Parallel()
+	d := writeInput(t, "\na\nb\n\n\nc\n")
+
+	blocks, err := d.Blocks()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer blocks.Close()
+
+	want := [][]string{{"a", "b"}, {"c"}}
+	wantLines := []int{2, 6}
+	got := make([][]string, 0)
+	for blocks.Scan() {
+		if i := len(got); i < len(wantLines) && blocks.Line() != wantLines[i] {
+			t.Errorf("block %d: want line %d, got %d", i, wantLines[i], blocks.Line())
+		}
+		got = append(got, blocks.Block())
+	}
+	if err := blocks.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if !slices.EqualFunc(want, got, slices.Equal[[]string]) {
+		t.Errorf("want %q, got %q", want, got)
+	}
+}
+
+func TestParseBlocksError(t *testing.T) {
+	t.Parallel()
+	d := writeInput(t, "1 2\n\n3\n4 x\n")
+
+	_, err := ParseBlocks(context.Background(), d, func(block []string) ([]int, error) {
+		result := make([]int, 0)
+		for i, line := range block {
+			n, err := Ints(line, 1)
+			if err != nil {
+				return nil, Locate(err, i+1)
+			}
+			result = append(result, n...)
+		}
+		return result, nil
+	})
+
+	var pe *ParseError
+	if !errors.As(err, &pe) || pe.Line != 4 || pe.Column != 3 || pe.File != d.Path() {
+		t.Errorf("want error at %s:4:3, got %v", d.Path(), err)
+	}
+}
+
+func TestReadByteGrid(t *testing.T) {
+	t.Parallel()
+	d := writeInput(t, "#.\n.#\n")
+
+	grid, err := d.ReadByteGrid(context.Background(), ".#")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [][]byte{[]byte("#."), []byte(".#")}
+	if !slices.EqualFunc(want, grid, slices.Equal[[]byte]) {
+		t.Errorf("want %q, got %q", want, grid)
+	}
+
+	// every call reads a fresh grid
+	grid[0][0] = '.'
+	again, err := d.ReadByteGrid(context.Background(), ".#")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again[0][0] != '#' {
+		t.Error("modifying the grid changed the next read")
+	}
+
+	for _, test := range []struct {
+		content      string
+		line, column int
+	}{
+		{"", 1, 0},
+		{"\n#\n", 1, 0},
+		{"..#\n.#\n", 2, 0},
+		{"..#\n.x.\n", 2, 2},
+	} {
+		_, err := writeInput(t, test.content).ReadByteGrid(context.Background(), ".#")
+		var pe *ParseError
+		if !errors.As(err, &pe) {
+			t.Fatalf("%q: want *ParseError, got %v", test.content, err)
+		}
+		if pe.Line != test.line || pe.Column != test.column {
+			t.Errorf("%q: want %d:%d, got %d:%d", test.content, test.line, test.column, pe.Line, pe.Column)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package day01
+
+import (
+	"context"
+	"maps"
+	"strings"
+
+	"adventofcode23/internal/day"
+)
+
+type Day01 struct {
+	day.DayInput
+}
+
+var (
+	digits = map[string]int{
+		"1": 1,
+		"2": 2,
+		"3": 3,
+		"4": 4,
+		"5": 5,
+		"6": 6,
+		"7": 7,
+		"8": 8,
+		"9": 9,
+	}
+	words = map[string]int{
+		"one":   1,
+		"two":   2,
+		"three": 3,
+		"four":  4,
+		"five":  5,
+		"six":   6,
+		"seven": 7,
+		"eight": 8,
+		"nine":  9,
+	}
+)
+
+func NewDay01(inputFile string) Day01 {
+	return Day01{day.NewInput(inputFile)}
+}
+
+func firstDigit(s string, digitValues map[string]int) int {
+	resultIndex := len(s)
+	resultValue := 0
+
+	for key, value := range digitValues {
+		index := strings.Index(s, key)
+		if index != -1 && index < resultIndex {
+			resultIndex = index
+			resultValue = value
+		}
+	}
+
+	return resultValue
+}
+
+func lastDigit(s string, digitValues map[string]int) int {
+	resultIndex := -1
+	resultValue := 0
+
+	for key, value := range digitValues {
+		index := strings.LastIndex(s, key)
+		if index != -1 && index > resultIndex {
+			resultIndex = index
+			resultValue = value
+		}
+	}
+
+	return resultValue
+}
+
+func calibrationValue(line string, digitValues map[string]int) (int, error) {
+	first := firstDigit(line, digitValues)
+	if first == 0 {
+		return 0, day.Errorf(0, "no digit in %q", line)
+	}
+	last := lastDigit(line, digitValues)
+	return 10*first + last, nil
+}
+
+func (d Day01) sumCalibrationValues(ctx context.Context, digitValues map[string]int) (int, error) {
+	values, err := day.ParseLines(ctx, d.DayInput, func(line string) (int, error) {
+		return calibrationValue(line, digitValues)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	sum := 0
+	for _, value := range values {
+		sum += value
+	}
+
+	return sum, nil
+}
+
+func (d Day01) Part1(ctx context.Context) (day.Answer, error) {
+	sum, err := d.sumCalibrationValues(ctx, digits)
+	if err != nil {
+		return day.Answer{}, err
+	}
+	return day.Int(sum), nil
+}
+
+func (d Day01) Part2(ctx context.Context) (day.Answer, error) {
+	digitsAndWords := maps.Clone(digits)
+	maps.Copy(digitsAndWords, words)
+
+	sum, err := d.sumCalibrationValues(ctx, digitsAndWords)
+	if err != nil {
+		return day.Answer{}, err
+	}
+	return day.Int(sum), nil
+}
+
+func init() {
+	day.Register(day.Solver{
+		Day: 1,
+		New: func(inputFile string) day.Day { return NewDay01(inputFile) },
+	})
+}
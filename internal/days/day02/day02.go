@@ -0,0 +1,135 @@
+package day02
+
+import (
+	"context"
+	"strings"
+
+	"adventofcode23/internal/day"
+)
+
+type Day02 struct {
+	day.DayInput
+}
+
+type game []map[string]int
+
+func NewDay02(inputFile string) Day02 {
+	return Day02{day.NewInput(inputFile)}
+}
+
+func isGamePossible(game game) bool {
+	for _, scoreMap := range game {
+		if !isRoundPossible(scoreMap) {
+			return false
+		}
+	}
+	return true
+}
+
+func isRoundPossible(scoreMap map[string]int) bool {
+	return scoreMap["red"] <= 12 && scoreMap["green"] <= 13 && scoreMap["blue"] <= 14
+}
+
+func power(game game) int {
+	required := map[string]int{
+		"red":   0,
+		"green": 0,
+		"blue":  0,
+	}
+
+	for _, scoreMap := range game {
+		for k, v := range scoreMap {
+			if v > required[k] {
+				required[k] = v
+			}
+		}
+	}
+
+	return required["red"] * required["green"] * required["blue"]
+}
+
+func scoreMap(round string, column int) (map[string]int, error) {
+	scoreMap := make(map[string]int)
+	scores := strings.Split(round, ", ")
+	for _, s := range scores {
+		c, color, ok := strings.Cut(s, " ")
+		if !ok {
+			return nil, day.Errorf(column, "invalid cube count %q", s)
+		}
+		score, err := day.Atoi(c, column)
+		if err != nil {
+			return nil, err
+		}
+		scoreMap[color] += score
+		column += len(s) + len(", ")
+	}
+	return scoreMap, nil
+}
+
+func parseGame(line string) (game, error) {
+	header, g, ok := strings.Cut(line, ": ")
+	if !ok {
+		return nil, day.Errorf(1, "missing game header")
+	}
+
+	rounds := strings.Split(g, "; ")
+	result := make(game, len(rounds))
+	column := len(header) + len(": ") + 1
+	for i, round := range rounds {
+		scoreMap, err := scoreMap(round, column)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = scoreMap
+		column += len(round) + len("; ")
+	}
+	return result, nil
+}
+
+// games returns the games, parsed once for both parts.
+func (d Day02) games(ctx context.Context) ([]game, error) {
+	return day.Once(d.DayInput, "games", func() ([]game, error) {
+		return day.ParseLines(ctx, d.DayInput, parseGame)
+	})
+}
+
+func (d Day02) Part1(ctx context.Context) (day.Answer, error) {
+	games, err := d.games(ctx)
+	if err != nil {
+		return day.Answer{}, err
+	}
+
+	sum := 0
+
+	for i, game := range games {
+		index := i + 1
+		if isGamePossible(game) {
+			sum += index
+		}
+	}
+
+	return day.Int(sum), nil
+}
+
+func (d Day02) Part2(ctx context.Context) (day.Answer, error) {
+	games, err := d.games(ctx)
+	if err != nil {
+		return day.Answer{}, err
+	}
+
+	sum := 0
+
+	for _, game := range games {
+		power := power(game)
+		sum += power
+	}
+
+	return day.Int(sum), nil
+}
+
+func init() {
+	day.Register(day.Solver{
+		Day: 2,
+		New: func(inputFile string) day.Day { return NewDay02(inputFile) },
+	})
+}
@@ -0,0 +1,131 @@
+package day03
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/grid"
+)
+
+var partNumberRE = regexp.MustCompile(`\d+`)
+
+type partNumber struct {
+	line, left, right, partNumber int
+}
+
+type Day03 struct {
+	day.DayInput
+}
+
+func NewDay03(inputFile string) Day03 {
+	return Day03{day.NewInput(inputFile)}
+}
+
+// neighbours returns the points surrounding the part number within the
+// schema.
+func (part partNumber) neighbours(schema grid.Grid[byte]) []grid.Point {
+	result := make([]grid.Point, 0)
+	for r := part.line - 1; r <= part.line+1; r++ {
+		for c := part.left - 1; c <= part.right; c++ {
+			p := grid.Point{Row: r, Column: c}
+			if r == part.line && c >= part.left && c < part.right {
+				continue
+			}
+			if schema.In(p) {
+				result = append(result, p)
+			}
+		}
+	}
+	return result
+}
+
+func surroundedByDots(part partNumber, schema grid.Grid[byte]) bool {
+	for _, p := range part.neighbours(schema) {
+		if schema.Get(p) != '.' {
+			return false
+		}
+	}
+	return true
+}
+
+func partNumbers(schema grid.Grid[byte]) []partNumber {
+	result := make([]partNumber, 0)
+	for i := 0; i < schema.Rows(); i++ {
+		line := schema.Row(i)
+		matches := partNumberRE.FindAllIndex(line, -1)
+		if matches == nil {
+			continue
+		}
+
+		for _, match := range matches {
+			number, _ := strconv.Atoi(string(line[match[0]:match[1]]))
+			part := partNumber{i, match[0], match[1], number}
+			if !surroundedByDots(part, schema) {
+				result = append(result, part)
+			}
+		}
+	}
+	return result
+}
+
+func attachedToGears(part partNumber, schema grid.Grid[byte]) []grid.Point {
+	result := make([]grid.Point, 0)
+	for _, p := range part.neighbours(schema) {
+		if schema.Get(p) == '*' {
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
+func gearMap(parts []partNumber, schema grid.Grid[byte]) map[grid.Point][]partNumber {
+	result := make(map[grid.Point][]partNumber)
+	for _, part := range parts {
+		gears := attachedToGears(part, schema)
+		for _, gear := range gears {
+			result[gear] = append(result[gear], part)
+		}
+	}
+	return result
+}
+
+func (d Day03) Part1(ctx context.Context) (day.Answer, error) {
+	input, err := d.ReadGrid(ctx, "")
+	if err != nil {
+		return day.Answer{}, err
+	}
+	schema := grid.Bytes(input)
+	partNumbers := partNumbers(schema)
+	sum := 0
+	for _, p := range partNumbers {
+		sum += p.partNumber
+	}
+	return day.Int(sum), nil
+}
+
+func (d Day03) Part2(ctx context.Context) (day.Answer, error) {
+	input, err := d.ReadGrid(ctx, "")
+	if err != nil {
+		return day.Answer{}, err
+	}
+	schema := grid.Bytes(input)
+	partNumbers := partNumbers(schema)
+	gearMap := gearMap(partNumbers, schema)
+	sum := 0
+	for _, gearList := range gearMap {
+		if len(gearList) == 2 {
+			gear := gearList[0].partNumber * gearList[1].partNumber
+			sum += gear
+		}
+	}
+	return day.Int(sum), nil
+}
+
+func init() {
+	day.Register(day.Solver{
+		Day: 3,
+		New: func(inputFile string) day.Day { return NewDay03(inputFile) },
+	})
+}
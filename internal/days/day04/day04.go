@@ -0,0 +1,98 @@
+package day04
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"adventofcode23/internal/day"
+)
+
+type Day04 struct {
+	day.DayInput
+}
+
+func NewDay04(inputFile string) Day04 {
+	return Day04{day.NewInput(inputFile)}
+}
+
+func countMatches(line string) (int, error) {
+	header, card, ok := strings.Cut(line, ": ")
+	if !ok {
+		return 0, day.Errorf(1, "missing card header")
+	}
+	winningNumbersStr, myNumbersStr, ok := strings.Cut(card, " | ")
+	if !ok {
+		return 0, day.Errorf(len(header)+3, "missing \" | \" separator")
+	}
+	winningNumbers, err := day.Ints(winningNumbersStr, len(header)+3)
+	if err != nil {
+		return 0, err
+	}
+	myNumbers, err := day.Ints(myNumbersStr, len(line)-len(myNumbersStr)+1)
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	for _, myNumber := range myNumbers {
+		if slices.Contains(winningNumbers, myNumber) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func cardValue(count int) int {
+	if count == 0 {
+		return 0
+	}
+	return 1 << (count - 1)
+}
+
+// matchCounts returns the number of matching numbers of every card, parsed once for both parts.
+func (d Day04) matchCounts(ctx context.Context) ([]int, error) {
+	return day.Once(d.DayInput, "matchCounts", func() ([]int, error) {
+		return day.ParseLines(ctx, d.DayInput, countMatches)
+	})
+}
+
+func (d Day04) Part1(ctx context.Context) (day.Answer, error) {
+	matchCount, err := d.matchCounts(ctx)
+	if err != nil {
+		return day.Answer{}, err
+	}
+	sum := 0
+	for _, count := range matchCount {
+		value := cardValue(count)
+		sum += value
+	}
+	return day.Int(sum), nil
+}
+
+func (d Day04) Part2(ctx context.Context) (day.Answer, error) {
+	matchCount, err := d.matchCounts(ctx)
+	if err != nil {
+		return day.Answer{}, err
+	}
+	copies := make([]int, len(matchCount))
+	for i := range copies {
+		copies[i] = 1
+	}
+	for i, count := range matchCount {
+		for j := 0; j < count; j++ {
+			copies[i+j+1] += copies[i]
+		}
+	}
+	sum := 0
+	for _, copy := range copies {
+		sum += copy
+	}
+	return day.Int(sum), nil
+}
+
+func init() {
+	day.Register(day.Solver{
+		Day: 4,
+		New: func(inputFile string) day.Day { return NewDay04(inputFile) },
+	})
+}
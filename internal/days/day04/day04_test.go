@@ -0,0 +1,16 @@
+package day04
+
+import (
+	"testing"
+
+	"adventofcode23/internal/day/daytest"
+)
+
+func TestExamples(t *testing.T) {
+	t.Parallel()
+	daytest.RunExamples(t)
+}
+
+func BenchmarkParts(b *testing.B) {
+	daytest.BenchmarkParts(b)
+}
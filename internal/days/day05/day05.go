@@ -0,0 +1,188 @@
+package day05
+
+import (
+	"context"
+	"errors"
+	"math"
+	"slices"
+	"strings"
+
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/parallel"
+)
+
+// ctxCheckInterval is the number of seeds between checks for cancellation.
+const ctxCheckInterval = 1 << 16
+
+var errOddSeeds = errors.New("seed ranges need an even number of seed numbers")
+
+var mapNames = []string{
+	"seed-to-soil",
+	"soil-to-fertilizer",
+	"fertilizer-to-water",
+	"water-to-light",
+	"light-to-temperature",
+	"temperature-to-humidity",
+	"humidity-to-location",
+}
+
+type numberRange struct {
+	destination, source, length int
+}
+
+type almanac struct {
+	seeds    []int
+	mappings [][]numberRange
+}
+
+type Day05 struct {
+	day.DayInput
+}
+
+func NewDay05(inputFile string) Day05 {
+	return Day05{day.NewInput(inputFile)}
+}
+
+func parseRange(line string) (numberRange, error) {
+	n, err := day.Ints(line, 1)
+	if err != nil {
+		return numberRange{}, err
+	}
+	if len(n) != 3 {
+		return numberRange{}, day.Errorf(1, "want destination, source and length, got %d numbers", len(n))
+	}
+	return numberRange{n[0], n[1], n[2]}, nil
+}
+
+func makeMapping(lines []string, start int) ([]numberRange, error) {
+	ranges := make([]numberRange, len(lines))
+	for i, line := range lines {
+		r, err := parseRange(line)
+		if err != nil {
+			return nil, day.Locate(err, start+i+1)
+		}
+		ranges[i] = r
+	}
+	return ranges, nil
+}
+
+func parseAlmanac(lines []string) (almanac, error) {
+	if len(lines) == 0 || !strings.HasPrefix(lines[0], "seeds: ") {
+		return almanac{}, day.Locate(day.Errorf(1, "missing seeds"), 1)
+	}
+
+	seeds, err := day.Ints(lines[0][len("seeds: "):], len("seeds: ")+1)
+	if err != nil {
+		return almanac{}, day.Locate(err, 1)
+	}
+	if len(seeds) == 0 {
+		return almanac{}, day.Locate(day.Errorf(len("seeds: ")+1, "no seeds"), 1)
+	}
+
+	mappings := make([][]numberRange, len(mapNames))
+	i := 1
+	for m, name := range mapNames {
+		for i < len(lines) && lines[i] == "" {
+			i++
+		}
+		if i == len(lines) || lines[i] != name+" map:" {
+			return almanac{}, day.Locate(day.Errorf(1, "missing %s map", name), i+1)
+		}
+		i++
+
+		start := i
+		for i < len(lines) && lines[i] != "" {
+			i++
+		}
+		mappings[m], err = makeMapping(lines[start:i], start)
+		if err != nil {
+			return almanac{}, err
+		}
+	}
+
+	return almanac{seeds, mappings}, nil
+}
+
+func findNext(seed int, mapping []numberRange) int {
+	for _, n := range mapping {
+		s := seed - n.source
+		if s >= 0 && s < n.length {
+			return n.destination + s
+		}
+	}
+	return seed
+}
+
+func findLocation(seed int, mappings [][]numberRange) int {
+	result := seed
+	for _, mapping := range mappings {
+		result = findNext(result, mapping)
+	}
+	return result
+}
+
+// almanac returns the almanac, parsed once for both parts.
+func (d Day05) almanac(ctx context.Context) (almanac, error) {
+	return day.Once(d.DayInput, "almanac", func() (almanac, error) {
+		return day.Parse(ctx, d.DayInput, parseAlmanac)
+	})
+}
+
+func (d Day05) Part1(ctx context.Context) (day.Answer, error) {
+	almanac, err := d.almanac(ctx)
+	if err != nil {
+		return day.Answer{}, err
+	}
+	seeds, mappings := almanac.seeds, almanac.mappings
+
+	locations := make([]int, len(seeds))
+	for i, seed := range seeds {
+		locations[i] = findLocation(seed, mappings)
+	}
+
+	location := slices.Min(locations)
+	return day.Int(location), nil
+}
+
+func (d Day05) Part2(ctx context.Context) (day.Answer, error) {
+	almanac, err := d.almanac(ctx)
+	if err != nil {
+		return day.Answer{}, err
+	}
+	seeds, mappings := almanac.seeds, almanac.mappings
+	if len(seeds)%2 != 0 {
+		return day.Answer{}, errOddSeeds
+	}
+
+	ranges := make([][2]int, 0, len(seeds)/2)
+	for i := 0; i < len(seeds); i += 2 {
+		ranges = append(ranges, [2]int{seeds[i], seeds[i] + seeds[i+1]})
+	}
+
+	// every range keeps its own minimum, so that workers share nothing
+	minima, err := parallel.Map(ctx, ranges, func(r [2]int) int {
+		result := math.MaxInt
+		for seed := r[0]; seed < r[1]; seed++ {
+			if seed%ctxCheckInterval == 0 && ctx.Err() != nil {
+				return result
+			}
+			result = min(result, findLocation(seed, mappings))
+		}
+		return result
+	})
+	if err != nil {
+		return day.Answer{}, err
+	}
+	if err := ctx.Err(); err != nil {
+		return day.Answer{}, err
+	}
+
+	return day.Int(slices.Min(minima)), nil
+}
+
+func init() {
+	day.Register(day.Solver{
+		Day: 5,
+		New: func(inputFile string) day.Day { return NewDay05(inputFile) },
+	})
+}
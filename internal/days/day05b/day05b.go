@@ -0,0 +1,169 @@
+package day05b
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strings"
+
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/interval"
+)
+
+var errOddSeeds = errors.New("seed ranges need an even number of seed numbers")
+
+var mapNames = []string{
+	"seed-to-soil",
+	"soil-to-fertilizer",
+	"fertilizer-to-water",
+	"water-to-light",
+	"light-to-temperature",
+	"temperature-to-humidity",
+	"humidity-to-location",
+}
+
+type almanac struct {
+	seeds    []int
+	mappings []interval.Map
+}
+
+type Day05b struct {
+	day.DayInput
+}
+
+func NewDay05b(inputFile string) Day05b {
+	return Day05b{day.NewInput(inputFile)}
+}
+
+func parseRange(line string) (interval.Piece, error) {
+	n, err := day.Ints(line, 1)
+	if err != nil {
+		return interval.Piece{}, err
+	}
+	if len(n) != 3 {
+		return interval.Piece{}, day.Errorf(1, "want destination, source and length, got %d numbers", len(n))
+	}
+	destination, source, length := n[0], n[1], n[2]
+	return interval.Piece{
+		Interval: interval.Interval{Start: source, End: source + length},
+		Offset:   destination - source,
+	}, nil
+}
+
+func parseMapping(lines []string, start int) (interval.Map, error) {
+	pieces := make([]interval.Piece, len(lines))
+	for i, line := range lines {
+		p, err := parseRange(line)
+		if err != nil {
+			return interval.Map{}, day.Locate(err, start+i+1)
+		}
+		pieces[i] = p
+	}
+
+	m, err := interval.NewMap(pieces...)
+	if err != nil {
+		return interval.Map{}, day.Locate(err, start+1)
+	}
+	return m, nil
+}
+
+func parseAlmanac(lines []string) (almanac, error) {
+	if len(lines) == 0 || !strings.HasPrefix(lines[0], "seeds: ") {
+		return almanac{}, day.Locate(day.Errorf(1, "missing seeds"), 1)
+	}
+
+	seeds, err := day.Ints(lines[0][len("seeds: "):], len("seeds: ")+1)
+	if err != nil {
+		return almanac{}, day.Locate(err, 1)
+	}
+	if len(seeds) == 0 {
+		return almanac{}, day.Locate(day.Errorf(len("seeds: ")+1, "no seeds"), 1)
+	}
+
+	mappings := make([]interval.Map, len(mapNames))
+	i := 1
+	for m, name := range mapNames {
+		for i < len(lines) && lines[i] == "" {
+			i++
+		}
+		if i == len(lines) || lines[i] != name+" map:" {
+			return almanac{}, day.Locate(day.Errorf(1, "missing %s map", name), i+1)
+		}
+		i++
+
+		start := i
+		for i < len(lines) && lines[i] != "" {
+			i++
+		}
+		mappings[m], err = parseMapping(lines[start:i], start)
+		if err != nil {
+			return almanac{}, err
+		}
+	}
+
+	return almanac{seeds, mappings}, nil
+}
+
+// minLocation maps the seeds through all mappings at once, by composing them,
+// and returns the lowest location.
+func minLocation(seeds interval.Set, mappings []interval.Map) int {
+	var seedToLocation interval.Map
+	for _, m := range mappings {
+		seedToLocation = seedToLocation.Then(m)
+	}
+
+	locations := seedToLocation.Image(seeds)
+	result, ok := locations.Min()
+	if !ok {
+		return math.MaxInt
+	}
+	return result
+}
+
+// almanac returns the almanac, parsed once for both parts.
+func (d Day05b) almanac(ctx context.Context) (almanac, error) {
+	return day.Once(d.DayInput, "almanac", func() (almanac, error) {
+		return day.Parse(ctx, d.DayInput, parseAlmanac)
+	})
+}
+
+func (d Day05b) Part1(ctx context.Context) (day.Answer, error) {
+	almanac, err := d.almanac(ctx)
+	if err != nil {
+		return day.Answer{}, err
+	}
+	seeds, mappings := almanac.seeds, almanac.mappings
+
+	seedRanges := make([]interval.Interval, len(seeds))
+	for i, seed := range seeds {
+		seedRanges[i] = interval.Interval{Start: seed, End: seed + 1}
+	}
+
+	return day.Int(minLocation(interval.NewSet(seedRanges...), mappings)), nil
+}
+
+func (d Day05b) Part2(ctx context.Context) (day.Answer, error) {
+	almanac, err := d.almanac(ctx)
+	if err != nil {
+		return day.Answer{}, err
+	}
+	seeds, mappings := almanac.seeds, almanac.mappings
+	if len(seeds)%2 != 0 {
+		return day.Answer{}, errOddSeeds
+	}
+
+	seedRanges := make([]interval.Interval, len(seeds)/2)
+	for i := 0; i < len(seeds); i += 2 {
+		seedRanges[i/2] = interval.Interval{Start: seeds[i], End: seeds[i] + seeds[i+1]}
+	}
+
+	return day.Int(minLocation(interval.NewSet(seedRanges...), mappings)), nil
+}
+
+func init() {
+	day.Register(day.Solver{
+		Day:     5,
+		Variant: "b",
+		New:     func(inputFile string) day.Day { return NewDay05b(inputFile) },
+	})
+}
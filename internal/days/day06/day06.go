@@ -0,0 +1,119 @@
+package day06
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/mathx"
+)
+
+type race struct {
+	time     int
+	distance int
+}
+
+type Day06 struct {
+	day.DayInput
+}
+
+func NewDay06(inputFile string) Day06 {
+	return Day06{day.NewInput(inputFile)}
+}
+
+func winRaceOptions(r race) int {
+	// quadratic formula
+	D := r.time*r.time - 4*r.distance
+	s := mathx.ISqrt(D)
+	perfectSquare := s*s == D
+	if s%2 == r.time%2 {
+		// if both are even or both are odd, you can fit one more win in
+		s++
+	}
+	if perfectSquare {
+		// tied with record, subtract both ties
+		return s - 2
+	}
+	return s
+}
+
+func parseRecord(line, header string) ([]int, error) {
+	label, values, ok := strings.Cut(line, ":")
+	if !ok || label != header {
+		return nil, day.Errorf(1, "missing %q", header+":")
+	}
+	return day.Ints(values, len(label)+2)
+}
+
+func parseRaces(lines []string) ([]race, error) {
+	if len(lines) != 2 {
+		return nil, day.Locate(fmt.Errorf("want 2 lines, got %d", len(lines)), 1)
+	}
+
+	times, err := parseRecord(lines[0], "Time")
+	if err != nil {
+		return nil, day.Locate(err, 1)
+	}
+	distances, err := parseRecord(lines[1], "Distance")
+	if err != nil {
+		return nil, day.Locate(err, 2)
+	}
+	if len(times) != len(distances) {
+		err := fmt.Errorf("%d distances for %d times", len(distances), len(times))
+		return nil, day.Locate(err, 2)
+	}
+
+	races := make([]race, len(times))
+	for i := range times {
+		races[i] = race{times[i], distances[i]}
+	}
+	return races, nil
+}
+
+// races returns the races, parsed once for both parts.
+func (d Day06) races(ctx context.Context) ([]race, error) {
+	return day.Once(d.DayInput, "races", func() ([]race, error) {
+		return day.Parse(ctx, d.DayInput, parseRaces)
+	})
+}
+
+func (d Day06) Part1(ctx context.Context) (day.Answer, error) {
+	races, err := d.races(ctx)
+	if err != nil {
+		return day.Answer{}, err
+	}
+
+	result := 1
+	for _, r := range races {
+		w := winRaceOptions(r)
+		result *= w
+	}
+	return day.Int(result), nil
+}
+
+func (d Day06) Part2(ctx context.Context) (day.Answer, error) {
+	races, err := d.races(ctx)
+	if err != nil {
+		return day.Answer{}, err
+	}
+
+	t := ""
+	dist := ""
+	for _, r := range races {
+		t += strconv.Itoa(r.time)
+		dist += strconv.Itoa(r.distance)
+	}
+	time, _ := strconv.Atoi(t)
+	distance, _ := strconv.Atoi(dist)
+	r := race{time, distance}
+	return day.Int(winRaceOptions(r)), nil
+}
+
+func init() {
+	day.Register(day.Solver{
+		Day: 6,
+		New: func(inputFile string) day.Day { return NewDay06(inputFile) },
+	})
+}
@@ -0,0 +1,155 @@
+package day08
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/mathx"
+)
+
+var nodeRE = regexp.MustCompile(`(\w+)\s*=\s*\((\w+),\s*(\w+)\)`)
+
+type node map[byte]string
+
+type documents struct {
+	directions string
+	graph      map[string]node
+}
+
+type Day08 struct {
+	day.DayInput
+}
+
+func NewDay08(inputFile string) Day08 {
+	return Day08{day.NewInput(inputFile)}
+}
+
+func parseNode(line string) (string, node, error) {
+	matches := nodeRE.FindStringSubmatch(line)
+	if matches == nil {
+		return "", nil, day.Errorf(1, "invalid node %q", line)
+	}
+	name := matches[1]
+	left := matches[2]
+	right := matches[3]
+	n := node{
+		'L': left,
+		'R': right,
+	}
+	return name, n, nil
+}
+
+func parseDocuments(input []string) (documents, error) {
+	if len(input) == 0 || len(input[0]) == 0 {
+		return documents{}, day.Locate(errors.New("missing directions"), 1)
+	}
+	directions := input[0]
+	if i := strings.IndexFunc(directions, func(r rune) bool { return r != 'L' && r != 'R' }); i != -1 {
+		return documents{}, day.Locate(day.Errorf(i+1, "invalid direction %q", directions[i]), 1)
+	}
+
+	graph := make(map[string]node)
+	for i := 2; i < len(input); i++ {
+		name, n, err := parseNode(input[i])
+		if err != nil {
+			return documents{}, day.Locate(err, i+1)
+		}
+		graph[name] = n
+	}
+
+	for i := 2; i < len(input); i++ {
+		name, _, _ := parseNode(input[i])
+		for _, next := range graph[name] {
+			if _, ok := graph[next]; !ok {
+				return documents{}, day.Locate(fmt.Errorf("unknown node %s", next), i+1)
+			}
+		}
+	}
+
+	return documents{directions, graph}, nil
+}
+
+// documents returns the documents, parsed once for both parts.
+func (d Day08) documents(ctx context.Context) (documents, error) {
+	return day.Once(d.DayInput, "documents", func() (documents, error) {
+		return day.Parse(ctx, d.DayInput, parseDocuments)
+	})
+}
+
+func (d Day08) Part1(ctx context.Context) (day.Answer, error) {
+	documents, err := d.documents(ctx)
+	if err != nil {
+		return day.Answer{}, err
+	}
+	directions, graph := documents.directions, documents.graph
+	if _, ok := graph["AAA"]; !ok {
+		return day.Answer{}, errors.New("no node AAA")
+	}
+
+	steps := 0
+	current := "AAA"
+	for current != "ZZZ" {
+		directionIndex := steps % len(directions)
+		direction := directions[directionIndex]
+		current = graph[current][direction]
+		steps++
+	}
+
+	return day.Int(steps), nil
+}
+
+func findCycle(start, directions string, graph map[string]node) int {
+	steps := 0
+	current := start
+	for !strings.HasSuffix(current, "Z") {
+		directionIndex := steps % len(directions)
+		direction := directions[directionIndex]
+		current = graph[current][direction]
+		steps++
+	}
+	return steps
+}
+
+func startState(graph map[string]node) []string {
+	result := make([]string, 0)
+	for k := range graph {
+		if strings.HasSuffix(k, "A") {
+			result = append(result, k)
+		}
+	}
+	return result
+}
+
+func (d Day08) Part2(ctx context.Context) (day.Answer, error) {
+	documents, err := d.documents(ctx)
+	if err != nil {
+		return day.Answer{}, err
+	}
+	directions, graph := documents.directions, documents.graph
+
+	current := startState(graph)
+	if len(current) == 0 {
+		return day.Answer{}, errors.New("no start nodes")
+	}
+	steps := make([]int, len(current))
+	for i, n := range current {
+		steps[i] = findCycle(n, directions, graph)
+	}
+
+	lcm, ok := mathx.LCM(steps[0], steps[1:]...)
+	if !ok {
+		return day.Answer{}, mathx.ErrOverflow
+	}
+	return day.Int(lcm), nil
+}
+
+func init() {
+	day.Register(day.Solver{
+		Day: 8,
+		New: func(inputFile string) day.Day { return NewDay08(inputFile) },
+	})
+}
@@ -0,0 +1,88 @@
+package day08
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/dot"
+)
+
+var _ day.Grapher = Day08{}
+
+// Dot returns the network with the left and right turns as edges. Part 1
+// marks AAA and ZZZ and highlights the way between them; part 2 marks all
+// the nodes ending in A and Z.
+func (d Day08) Dot(ctx context.Context, part int) (*dot.Graph, error) {
+	documents, err := d.documents(ctx)
+	if err != nil {
+		return nil, err
+	}
+	directions, graph := documents.directions, documents.graph
+
+	isStart := func(n string) bool { return n == "AAA" }
+	isEnd := func(n string) bool { return n == "ZZZ" }
+	if part == 2 {
+		isStart = func(n string) bool { return strings.HasSuffix(n, "A") }
+		isEnd = func(n string) bool { return strings.HasSuffix(n, "Z") }
+	}
+
+	// turns taken on the way from AAA to ZZZ, which ends when the way runs
+	// in circles without reaching ZZZ
+	type state struct {
+		node string
+		step int
+	}
+	taken := make(map[string]map[byte]bool)
+	seen := make(map[state]bool)
+	if _, ok := graph["AAA"]; ok && part == 1 {
+		for steps, current := 0, "AAA"; current != "ZZZ"; steps++ {
+			s := state{current, steps % len(directions)}
+			if seen[s] {
+				break
+			}
+			seen[s] = true
+			direction := directions[s.step]
+			if taken[current] == nil {
+				taken[current] = make(map[byte]bool)
+			}
+			taken[current][direction] = true
+			current = graph[current][direction]
+		}
+	}
+
+	names := make([]string, 0, len(graph))
+	for name := range graph {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	g := dot.New("day08", true)
+	for _, name := range names {
+		switch {
+		case isStart(name):
+			g.Node(name, dot.Start)
+		case isEnd(name):
+			g.Node(name, dot.End)
+		}
+	}
+	for _, name := range names {
+		n := graph[name]
+		if n['L'] == n['R'] {
+			g.Edge(name, n['L'], turnAttrs("L,R", taken[name]['L'] || taken[name]['R']))
+			continue
+		}
+		g.Edge(name, n['L'], turnAttrs("L", taken[name]['L']))
+		g.Edge(name, n['R'], turnAttrs("R", taken[name]['R']))
+	}
+	return g, nil
+}
+
+func turnAttrs(label string, taken bool) dot.Attrs {
+	attrs := dot.Attrs{"label": label}
+	if taken {
+		return attrs.With(dot.Highlight)
+	}
+	return attrs
+}
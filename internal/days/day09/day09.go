@@ -0,0 +1,131 @@
+package day09
+
+import (
+	"context"
+	"errors"
+
+	"adventofcode23/internal/day"
+)
+
+type Day09 struct {
+	day.DayInput
+}
+
+func NewDay09(inputFile string) Day09 {
+	return Day09{day.NewInput(inputFile)}
+}
+
+func allZeroes(s []int) bool {
+	for _, i := range s {
+		if i != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func makeTriangle(sequence []int) [][]int {
+	result := make([][]int, 0)
+	n := 0
+	result = append(result, sequence)
+	for !allZeroes(result[n]) {
+		s := make([]int, len(result[n])-1)
+		for i := range s {
+			s[i] = result[n][i+1] - result[n][i]
+		}
+		n++
+		result = append(result, s)
+	}
+	return result
+}
+
+func expandTriangleForward(triangle [][]int) [][]int {
+	depth := len(triangle)
+	result := make([][]int, depth)
+	result[depth-1] = make([]int, len(triangle[depth-1])+1)
+
+	for i := depth - 2; i >= 0; i-- {
+		result[i] = make([]int, len(triangle[i])+1)
+		_ = copy(result[i], triangle[i])
+		result[i][len(triangle[i])] = result[i][len(triangle[i])-1] + result[i+1][len(result[i+1])-1]
+	}
+
+	return result
+}
+
+func extrapolateForward(sequence []int) int {
+	triangle := makeTriangle(sequence)
+	expandedTriangle := expandTriangleForward(triangle)
+	return expandedTriangle[0][len(expandedTriangle[0])-1]
+}
+
+func parseSequence(line string) ([]int, error) {
+	sequence, err := day.Ints(line, 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(sequence) == 0 {
+		return nil, errors.New("empty sequence")
+	}
+	return sequence, nil
+}
+
+// sequences returns the sequences, parsed once for both parts.
+func (d Day09) sequences(ctx context.Context) ([][]int, error) {
+	return day.Once(d.DayInput, "sequences", func() ([][]int, error) {
+		return day.ParseLines(ctx, d.DayInput, parseSequence)
+	})
+}
+
+func (d Day09) Part1(ctx context.Context) (day.Answer, error) {
+	sequences, err := d.sequences(ctx)
+	if err != nil {
+		return day.Answer{}, err
+	}
+	sum := 0
+	for _, sequence := range sequences {
+		nextValue := extrapolateForward(sequence)
+		sum += nextValue
+	}
+	return day.Int(sum), nil
+}
+
+func expandTriangleBackward(triangle [][]int) [][]int {
+	depth := len(triangle)
+	result := make([][]int, depth)
+	result[depth-1] = make([]int, len(triangle[depth-1])+1)
+
+	for i := depth - 2; i >= 0; i-- {
+		result[i] = make([]int, len(triangle[i])+1)
+		_ = copy(result[i][1:], triangle[i])
+		result[i][0] = result[i][1] - result[i+1][0]
+	}
+
+	return result
+}
+
+func extrapolateBackward(sequence []int) int {
+	triangle := makeTriangle(sequence)
+	expandedTriangle := expandTriangleBackward(triangle)
+	return expandedTriangle[0][0]
+}
+
+func (d Day09) Part2(ctx context.Context) (day.Answer, error) {
+	sequences, err := d.sequences(ctx)
+	if err != nil {
+		return day.Answer{}, err
+	}
+	sum := 0
+	for _, sequence := range sequences {
+		nextValue := extrapolateBackward(sequence)
+		sum += nextValue
+	}
+	return day.Int(sum), nil
+}
+
+func init() {
+	day.Register(day.Solver{
+		Day: 9,
+		New: func(inputFile string) day.Day { return NewDay09(inputFile) },
+	})
+}
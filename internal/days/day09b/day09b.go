@@ -0,0 +1,116 @@
+package day09b
+
+import (
+	"context"
+	"errors"
+
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/mathx"
+)
+
+type Day09b struct {
+	day.DayInput
+}
+
+func NewDay09b(inputFile string) Day09b {
+	return Day09b{day.NewInput(inputFile)}
+}
+
+func even(n int) bool {
+	return n%2 == 0
+}
+
+func sign(n int) int {
+	if even(n) {
+		return 1
+	}
+	return -1
+}
+
+// extrapolate returns the next value of row by the alternating sum of its
+// values weighted with binomial coefficients.
+func extrapolate(row []int) (int, error) {
+	n := len(row)
+	sign := sign(n + 1)
+	result := 0
+	for i := 0; i < n; i++ {
+		c, ok := mathx.Binomial(n, i)
+		if !ok {
+			return 0, mathx.ErrOverflow
+		}
+		r, ok := mathx.Mul(sign*row[i], c)
+		if !ok {
+			return 0, mathx.ErrOverflow
+		}
+		result += r
+		sign = -sign
+	}
+	return result, nil
+}
+
+func reverse(r []int) []int {
+	n := len(r)
+	result := make([]int, n)
+	for i, e := range r {
+		result[n-1-i] = e
+	}
+	return result
+}
+
+func parseSequence(line string) ([]int, error) {
+	sequence, err := day.Ints(line, 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(sequence) == 0 {
+		return nil, errors.New("empty sequence")
+	}
+	return sequence, nil
+}
+
+// sequences returns the sequences, parsed once for both parts.
+func (d Day09b) sequences(ctx context.Context) ([][]int, error) {
+	return day.Once(d.DayInput, "sequences", func() ([][]int, error) {
+		return day.ParseLines(ctx, d.DayInput, parseSequence)
+	})
+}
+
+func (d Day09b) Part1(ctx context.Context) (day.Answer, error) {
+	sequences, err := d.sequences(ctx)
+	if err != nil {
+		return day.Answer{}, err
+	}
+	sum := 0
+	for _, sequence := range sequences {
+		s, err := extrapolate(sequence)
+		if err != nil {
+			return day.Answer{}, err
+		}
+		sum += s
+	}
+	return day.Int(sum), nil
+}
+
+func (d Day09b) Part2(ctx context.Context) (day.Answer, error) {
+	sequences, err := d.sequences(ctx)
+	if err != nil {
+		return day.Answer{}, err
+	}
+	sum := 0
+	for _, sequence := range sequences {
+		s, err := extrapolate(reverse(sequence))
+		if err != nil {
+			return day.Answer{}, err
+		}
+		sum += s
+	}
+	return day.Int(sum), nil
+}
+
+func init() {
+	day.Register(day.Solver{
+		Day:     9,
+		Variant: "b",
+		New:     func(inputFile string) day.Day { return NewDay09b(inputFile) },
+	})
+}
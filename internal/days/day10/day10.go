@@ -0,0 +1,204 @@
+package day10
+
+import (
+	"context"
+	"errors"
+
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/grid"
+)
+
+var (
+	errNoStart           = errors.New("no starting position S")
+	errStartNotConnected = errors.New("starting position S is not connected to two pipes")
+)
+
+type Day10 struct {
+	day.DayInput
+}
+
+func NewDay10(inputFile string) Day10 {
+	return Day10{day.NewInput(inputFile)}
+}
+
+// Diagram is the field of pipes, padded with ground so that every tile of the
+// input has four neighbours.
+type Diagram struct {
+	grid.Grid[byte]
+}
+
+func (d Diagram) connectsNorth(t grid.Point) bool {
+	b := d.Get(t)
+	return b == '|' || b == 'L' || b == 'J'
+}
+
+func (d Diagram) connectsSouth(t grid.Point) bool {
+	b := d.Get(t)
+	return b == '|' || b == '7' || b == 'F'
+}
+
+func (d Diagram) connectsEast(t grid.Point) bool {
+	b := d.Get(t)
+	return b == '-' || b == 'L' || b == 'F'
+}
+
+func (d Diagram) connectsWest(t grid.Point) bool {
+	b := d.Get(t)
+	return b == '-' || b == '7' || b == 'J'
+}
+
+func (d Diagram) findS() (S, next grid.Point, valueS byte, err error) {
+	// picks one of the two tiles connected to S as next tile
+	S, found := d.Find(func(b byte) bool { return b == 'S' })
+	if !found {
+		return S, next, 0, errNoStart
+	}
+
+	n := S.Move(grid.North)
+	s := S.Move(grid.South)
+	e := S.Move(grid.East)
+	w := S.Move(grid.West)
+	switch {
+	case d.connectsSouth(n) && d.connectsNorth(s):
+		return S, n, '|', nil
+	case d.connectsSouth(n) && d.connectsEast(w):
+		return S, n, 'J', nil
+	case d.connectsSouth(n) && d.connectsWest(e):
+		return S, n, 'L', nil
+	case d.connectsNorth(s) && d.connectsEast(w):
+		return S, s, '7', nil
+	case d.connectsNorth(s) && d.connectsWest(e):
+		return S, s, 'F', nil
+	case d.connectsEast(w) && d.connectsWest(e):
+		return S, w, '-', nil
+	default:
+		return S, next, 0, errStartNotConnected
+	}
+}
+
+func (d Diagram) nextTile(current, previous grid.Point) grid.Point {
+	var r, l grid.Direction
+	switch d.Get(current) {
+	case '|':
+		l = grid.North
+		r = grid.South
+	case '-':
+		l = grid.East
+		r = grid.West
+	case 'L':
+		l = grid.North
+		r = grid.East
+	case '7':
+		l = grid.South
+		r = grid.West
+	case 'F':
+		l = grid.South
+		r = grid.East
+	default:
+		l = grid.North
+		r = grid.West
+	}
+
+	if current.Move(l) == previous {
+		return current.Move(r)
+	}
+	return current.Move(l)
+}
+
+func (d Day10) Part1(ctx context.Context) (day.Answer, error) {
+	input, err := d.ReadGrid(ctx, "|-LJ7F.S")
+	if err != nil {
+		return day.Answer{}, err
+	}
+	diagram := makeDiagram(input)
+	S, current, _, err := diagram.findS()
+	if err != nil {
+		return day.Answer{}, err
+	}
+	previous := S
+	length := 1
+
+	for current != S {
+		current, previous = diagram.nextTile(current, previous), current
+		length++
+	}
+	return day.Int(length / 2), nil
+}
+
+func odd(num int) bool {
+	return num%2 == 1
+}
+
+func isInside(mainLoop grid.Grid[byte], t grid.Point) bool {
+	crossed := 0
+	m := min(t.Row, t.Column)
+	for i := 1; i <= m; i++ {
+		v := mainLoop.Get(t.Sub(grid.Point{Row: i, Column: i}))
+		if v == '|' || v == '-' || v == 'J' || v == 'F' {
+			crossed++
+		}
+	}
+
+	return odd(crossed)
+}
+
+func countInside(mainLoop grid.Grid[byte]) int {
+	result := 0
+	for i := 0; i < mainLoop.Rows(); i++ {
+		for j := 0; j < mainLoop.Columns(); j++ {
+			t := grid.Point{Row: i, Column: j}
+			if mainLoop.Get(t) != 0 {
+				// part of the main loop
+				continue
+			}
+
+			if isInside(mainLoop, t) {
+				result++
+			}
+		}
+	}
+	return result
+}
+
+func makeDiagram(input []string) Diagram {
+	return Diagram{grid.Bytes(input).Pad(1, '.')}
+}
+
+// mainLoop returns the pipes of the loop through S, with S replaced by the
+// pipe it stands for, and zero for all other tiles.
+func (d Diagram) mainLoop() (grid.Grid[byte], error) {
+	result := grid.New[byte](d.Rows(), d.Columns())
+
+	S, current, valueS, err := d.findS()
+	if err != nil {
+		return result, err
+	}
+	result.Set(S, valueS)
+	previous := S
+
+	for current != S {
+		result.Set(current, d.Get(current))
+		current, previous = d.nextTile(current, previous), current
+	}
+	return result, nil
+}
+
+func (d Day10) Part2(ctx context.Context) (day.Answer, error) {
+	input, err := d.ReadGrid(ctx, "|-LJ7F.S")
+	if err != nil {
+		return day.Answer{}, err
+	}
+	mainLoop, err := makeDiagram(input).mainLoop()
+	if err != nil {
+		return day.Answer{}, err
+	}
+
+	return day.Int(countInside(mainLoop)), nil
+}
+
+func init() {
+	day.Register(day.Solver{
+		Day: 10,
+		New: func(inputFile string) day.Day { return NewDay10(inputFile) },
+	})
+}
@@ -0,0 +1,44 @@
+package day10
+
+import (
+	"context"
+
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/grid"
+	"adventofcode23/internal/render"
+)
+
+var _ day.Renderer = Day10{}
+
+// Render draws the main loop, and for part 2 the tiles it encloses.
+func (d Day10) Render(ctx context.Context, part int) (*render.Picture, error) {
+	input, err := d.ReadGrid(ctx, "|-LJ7F.S")
+	if err != nil {
+		return nil, err
+	}
+	diagram := makeDiagram(input)
+	mainLoop, err := diagram.mainLoop()
+	if err != nil {
+		return nil, err
+	}
+
+	result := render.New(len(input), len(input[0]))
+	for r := range input {
+		result.SetText(r, 0, []byte(input[r]))
+	}
+	// skip the padding
+	for r := 1; r < diagram.Rows()-1; r++ {
+		for c := 1; c < diagram.Columns()-1; c++ {
+			t := grid.Point{Row: r, Column: c}
+			switch {
+			case diagram.Get(t) == 'S':
+				result.Paint(r-1, c-1, render.Start)
+			case mainLoop.Get(t) != 0:
+				result.Paint(r-1, c-1, render.Path)
+			case part == 2 && isInside(mainLoop, t):
+				result.Paint(r-1, c-1, render.Fill)
+			}
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,110 @@
+package day11
+
+import (
+	"context"
+
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/grid"
+)
+
+type Day11 struct {
+	day.DayInput
+	expansionPart1, expansionPart2 int
+}
+
+type space struct {
+	galaxies                []grid.Point
+	rowWidths, columnWidths []int
+}
+
+func NewDay11(inputFile string, expansionPart1, expansionPart2 int) Day11 {
+	return Day11{day.NewInput(inputFile), expansionPart1, expansionPart2}
+}
+
+func parseInput(lines []string, expansion int) space {
+	image := grid.Bytes(lines)
+	galaxies := make([]grid.Point, 0)
+	rowWidths := make([]int, image.Rows())
+	for i := range rowWidths {
+		rowWidths[i] = expansion
+	}
+	columnWidths := make([]int, image.Columns())
+	for i := range columnWidths {
+		columnWidths[i] = expansion
+	}
+
+	for row := 0; row < image.Rows(); row++ {
+		for column, c := range image.Row(row) {
+			if c == '.' {
+				continue
+			}
+			galaxies = append(galaxies, grid.Point{Row: row, Column: column})
+			rowWidths[row] = 1
+			columnWidths[column] = 1
+		}
+	}
+
+	return space{galaxies, rowWidths, columnWidths}
+}
+
+func expand(indices []int) []int {
+	result := make([]int, len(indices))
+
+	sumPrevious := 0
+	for i, index := range indices {
+		result[i] = sumPrevious
+		sumPrevious += index
+	}
+
+	return result
+}
+
+func sumDistances(galaxies []grid.Point) int {
+	sum := 0
+	for i, a := range galaxies {
+		for _, b := range galaxies[:i+1] {
+			sum += a.Manhattan(b)
+		}
+	}
+	return sum
+}
+
+func (s space) expand() []grid.Point {
+	expandedRows := expand(s.rowWidths)
+	expandedColumns := expand(s.columnWidths)
+
+	result := make([]grid.Point, len(s.galaxies))
+
+	for i, g := range s.galaxies {
+		result[i] = grid.Point{Row: expandedRows[g.Row], Column: expandedColumns[g.Column]}
+	}
+
+	return result
+}
+
+func (d Day11) Part1(ctx context.Context) (day.Answer, error) {
+	lines, err := d.ReadGrid(ctx, ".#")
+	if err != nil {
+		return day.Answer{}, err
+	}
+	space := parseInput(lines, d.expansionPart1)
+
+	return day.Int(sumDistances(space.expand())), nil
+}
+
+func (d Day11) Part2(ctx context.Context) (day.Answer, error) {
+	lines, err := d.ReadGrid(ctx, ".#")
+	if err != nil {
+		return day.Answer{}, err
+	}
+	space := parseInput(lines, d.expansionPart2)
+
+	return day.Int(sumDistances(space.expand())), nil
+}
+
+func init() {
+	day.Register(day.Solver{
+		Day: 11,
+		New: func(inputFile string) day.Day { return NewDay11(inputFile, 2, 1000000) },
+	})
+}
@@ -1,21 +1,18 @@
-package main
+package day11
 
 import (
+	"context"
 	"path/filepath"
 	"testing"
 
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/day/daytest"
 	"adventofcode23/internal/projectpath"
 )
 
-func TestExamplePart1(t *testing.T) {
+func TestExamples(t *testing.T) {
 	t.Parallel()
-	d := NewDay11(filepath.Join(projectpath.Root, "cmd", "day11", "example.txt"), 2, 0)
-
-	want := 374
-	got := d.Part1()
-	if want != got {
-		t.Errorf("want %d, got %d", want, got)
-	}
+	daytest.RunExamples(t)
 }
 
 func TestExamplePart2(t *testing.T) {
@@ -37,9 +34,16 @@ func TestExamplePart2(t *testing.T) {
 
 	for i, tc := range testCases {
 		d := NewDay11(filepath.Join(projectpath.Root, "cmd", "day11", "example.txt"), 0, tc.expansion)
-		got := d.Part2()
-		if tc.want != got {
-			t.Errorf("test %d: want %d, got %d", i, tc.want, got)
+		got, err := d.Part2(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := day.Int(tc.want); !want.Equal(got) {
+			t.Errorf("test %d: want %v, got %v", i, want, got)
 		}
 	}
 }
+
+func BenchmarkParts(b *testing.B) {
+	daytest.BenchmarkParts(b)
+}
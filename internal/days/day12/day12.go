@@ -0,0 +1,65 @@
+package day12
+
+import (
+	"context"
+	"math/big"
+
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/parallel"
+	"adventofcode23/internal/springs"
+)
+
+type Day12 struct {
+	day.DayInput
+}
+
+func NewDay12(inputFile string) Day12 {
+	return Day12{day.NewInput(inputFile)}
+}
+
+// unfolds is how many copies of each row part 2 is about.
+const unfolds = 5
+
+// countAll sums the arrangements of all rows unfolded factor times, counting
+// rows in parallel.
+func countAll(ctx context.Context, rows []springs.Row, factor int) (*big.Int, error) {
+	counts, err := parallel.Map(ctx, rows, func(r springs.Row) *big.Int {
+		return r.Unfold(factor).Count()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sum := new(big.Int)
+	for _, c := range counts {
+		sum.Add(sum, c)
+	}
+	return sum, nil
+}
+
+func (d Day12) count(ctx context.Context, factor int) (day.Answer, error) {
+	rows, err := day.ParseLines(ctx, d.DayInput, springs.Parse)
+	if err != nil {
+		return day.Answer{}, err
+	}
+	sum, err := countAll(ctx, rows, factor)
+	if err != nil {
+		return day.Answer{}, err
+	}
+	return day.BigInt(sum), nil
+}
+
+func (d Day12) Part1(ctx context.Context) (day.Answer, error) {
+	return d.count(ctx, 1)
+}
+
+func (d Day12) Part2(ctx context.Context) (day.Answer, error) {
+	return d.count(ctx, unfolds)
+}
+
+func init() {
+	day.Register(day.Solver{
+		Day: 12,
+		New: func(inputFile string) day.Day { return NewDay12(inputFile) },
+	})
+}
@@ -0,0 +1,37 @@
+package day12
+
+import (
+	"context"
+	"testing"
+
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/day/daytest"
+	"adventofcode23/internal/springs"
+)
+
+func TestExamples(t *testing.T) {
+	t.Parallel()
+	daytest.RunExamples(t)
+}
+
+func BenchmarkParts(b *testing.B) {
+	daytest.BenchmarkParts(b)
+}
+
+func BenchmarkCountLayouts(b *testing.B) {
+	rows, err := day.ParseLines(context.Background(), NewDay12(daytest.Input(b, 2)).DayInput, springs.Parse)
+	if err != nil {
+		b.Fatal(err)
+	}
+	for i, r := range rows {
+		rows[i] = r.Unfold(unfolds)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, r := range rows {
+			r.Count()
+		}
+	}
+}
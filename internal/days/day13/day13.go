@@ -0,0 +1,95 @@
+package day13
+
+import (
+	"context"
+	"fmt"
+
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/grid"
+)
+
+type Day13 struct {
+	day.DayInput
+}
+
+func NewDay13(inputFile string) Day13 {
+	return Day13{day.NewInput(inputFile)}
+}
+
+func reflect(pattern grid.Grid[byte], r int) int {
+	smudgesFound := 0
+	for f, b := r, r-1; b >= 0 && f < pattern.Rows(); b, f = b-1, f+1 {
+		back, front := pattern.Row(b), pattern.Row(f)
+		for i := range back {
+			if back[i] != front[i] {
+				smudgesFound++
+			}
+		}
+	}
+	return smudgesFound
+}
+
+func reflection(pattern grid.Grid[byte], nSmudges int) int {
+	for i := 1; i < pattern.Rows(); i++ {
+		if reflect(pattern, i) == nSmudges {
+			return i
+		}
+	}
+
+	return 0
+}
+
+func parsePattern(block []string) (grid.Grid[byte], error) {
+	return grid.Parse(block, func(b byte) (byte, error) {
+		if b != '.' && b != '#' {
+			return 0, fmt.Errorf("unexpected character %q", b)
+		}
+		return b, nil
+	})
+}
+
+func sumNotes(patterns []grid.Grid[byte], nSmudges int) int {
+	sum := 0
+	for _, pattern := range patterns {
+		r := reflection(pattern, nSmudges)
+		sum += 100 * r
+
+		transposed := pattern.Transpose()
+		s := reflection(transposed, nSmudges)
+		sum += s
+	}
+
+	return sum
+}
+
+// patterns returns the patterns, parsed once for both parts.
+func (d Day13) patterns(ctx context.Context) ([]grid.Grid[byte], error) {
+	return day.Once(d.DayInput, "patterns", func() ([]grid.Grid[byte], error) {
+		return day.ParseBlocks(ctx, d.DayInput, parsePattern)
+	})
+}
+
+func (d Day13) Part1(ctx context.Context) (day.Answer, error) {
+	patterns, err := d.patterns(ctx)
+	if err != nil {
+		return day.Answer{}, err
+	}
+
+	return day.Int(sumNotes(patterns, 0)), nil
+}
+
+func (d Day13) Part2(ctx context.Context) (day.Answer, error) {
+	patterns, err := d.patterns(ctx)
+	if err != nil {
+		return day.Answer{}, err
+	}
+
+	return day.Int(sumNotes(patterns, 1)), nil
+}
+
+func init() {
+	day.Register(day.Solver{
+		Day: 13,
+		New: func(inputFile string) day.Day { return NewDay13(inputFile) },
+	})
+}
@@ -0,0 +1,64 @@
+package day14
+
+import (
+	"context"
+
+	"adventofcode23/internal/cycle"
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/grid"
+	"adventofcode23/internal/render"
+)
+
+var _ day.Animator = Day14{}
+
+func draw(picture *render.Picture, g grid.Grid[byte]) {
+	for r := 0; r < g.Rows(); r++ {
+		picture.SetText(r, 0, g.Row(r))
+		for c, ch := range g.Row(r) {
+			switch ch {
+			case 'O':
+				picture.Paint(r, c, render.Highlight)
+			case '#':
+				picture.Paint(r, c, render.Wall)
+			default:
+				picture.Paint(r, c, render.Plain)
+			}
+		}
+	}
+}
+
+// Animate shows the rocks rolling north row by row for part 1, and the
+// platform after every tilt of the spin cycles until they repeat for part 2.
+func (d Day14) Animate(ctx context.Context, part int, record func(*render.Picture)) error {
+	lines, err := d.ReadGrid(ctx, ".#O")
+	if err != nil {
+		return err
+	}
+	p := platform{grid.Bytes(lines)}
+	picture := render.New(p.Rows(), p.Columns())
+	draw(picture, p.Grid)
+	record(picture)
+
+	if part == 1 {
+		p.tilt(func() {
+			draw(picture, p.Grid)
+			record(picture)
+		})
+		return nil
+	}
+
+	c, err := cycle.Brent(ctx, p, platform.spun, platform.equal)
+	if err != nil {
+		return err
+	}
+	for i := 0; i < c.Len(); i++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		p.cycle(func(g grid.Grid[byte]) {
+			draw(picture, g)
+			record(picture)
+		})
+	}
+	return nil
+}
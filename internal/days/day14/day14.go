@@ -0,0 +1,117 @@
+package day14
+
+import (
+	"context"
+
+	"adventofcode23/internal/cycle"
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/grid"
+)
+
+type Day14 struct {
+	day.DayInput
+}
+
+type platform struct {
+	grid.Grid[byte]
+}
+
+func NewDay14(inputFile string) Day14 {
+	return Day14{day.NewInput(inputFile)}
+}
+
+// tilt rolls the rounded rocks north. When moved is set, it is called after
+// the rocks of every row have moved.
+func (p platform) tilt(moved func()) {
+	north := make([]int, p.Columns())
+
+	for i := 0; i < p.Rows(); i++ {
+		for j, c := range p.Row(i) {
+			switch c {
+			case 'O':
+				p.Set(grid.Point{Row: i, Column: j}, p.Get(grid.Point{Row: north[j], Column: j}))
+				p.Set(grid.Point{Row: north[j], Column: j}, 'O')
+				north[j]++
+			case '#':
+				north[j] = i + 1
+			}
+		}
+		if moved != nil {
+			moved()
+		}
+	}
+}
+
+func (d Day14) Part1(ctx context.Context) (day.Answer, error) {
+	lines, err := d.ReadGrid(ctx, ".#O")
+	if err != nil {
+		return day.Answer{}, err
+	}
+
+	p := platform{grid.Bytes(lines)}
+	p.tilt(nil)
+
+	return day.Int(p.load()), nil
+}
+
+func (p platform) load() int {
+	result := 0
+	for i := 0; i < p.Rows(); i++ {
+		for _, c := range p.Row(i) {
+			if c == 'O' {
+				result += p.Rows() - i
+			}
+		}
+	}
+	return result
+}
+
+// cycle spins p once. When tilted is set, it is called after every tilt with
+// the platform turned back to the north.
+func (p *platform) cycle(tilted func(grid.Grid[byte])) {
+	for i := 0; i < 4; i++ {
+		p.tilt(nil)
+		if tilted != nil {
+			g := p.Grid
+			for j := 0; j < i; j++ {
+				g = g.RotateCounterclockwise()
+			}
+			tilted(g)
+		}
+		p.Grid = p.RotateClockwise()
+	}
+}
+
+// spun returns a copy of p after a spin cycle, leaving p as it is.
+func (p platform) spun() platform {
+	result := platform{p.Clone()}
+	result.cycle(nil)
+	return result
+}
+
+func (p platform) equal(q platform) bool {
+	return grid.Equal(p.Grid, q.Grid)
+}
+
+func (d Day14) Part2(ctx context.Context) (day.Answer, error) {
+	lines, err := d.ReadGrid(ctx, ".#O")
+	if err != nil {
+		return day.Answer{}, err
+	}
+
+	p := platform{grid.Bytes(lines)}
+
+	c, err := cycle.Brent(ctx, p, platform.spun, platform.equal)
+	if err != nil {
+		return day.Answer{}, err
+	}
+
+	return day.Int(cycle.At(p, platform.spun, c, 1000000000).load()), nil
+}
+
+func init() {
+	day.Register(day.Solver{
+		Day: 14,
+		New: func(inputFile string) day.Day { return NewDay14(inputFile) },
+	})
+}
@@ -0,0 +1,173 @@
+package day14b
+
+import (
+	"context"
+
+	"github.com/cespare/xxhash/v2"
+
+	"adventofcode23/internal/cycle"
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/grid"
+)
+
+type Day14b struct {
+	day.DayInput
+}
+
+// platform keeps its spots in a single slice, row by row, so that tilting and
+// hashing work on contiguous memory.
+type platform struct {
+	grid.Grid[byte]
+}
+
+func NewDay14b(inputFile string) Day14b {
+	return Day14b{day.NewInput(inputFile)}
+}
+
+func (p platform) tiltNorth() {
+	spots := p.Cells()
+	for c := 0; c < p.Columns(); c++ {
+		north := 0
+		for r := 0; r < p.Rows(); r++ {
+			i := r*p.Columns() + c
+			switch spots[i] {
+			case 'O':
+				j := north*p.Columns() + c
+				spots[i], spots[j] = spots[j], spots[i]
+				north++
+			case '#':
+				north = r + 1
+			}
+		}
+	}
+}
+
+func (p platform) tiltWest() {
+	spots := p.Cells()
+	for r := 0; r < p.Rows(); r++ {
+		west := 0
+		for c := 0; c < p.Columns(); c++ {
+			i := r*p.Columns() + c
+			switch spots[i] {
+			case 'O':
+				j := r*p.Columns() + west
+				spots[i], spots[j] = spots[j], spots[i]
+				west++
+			case '#':
+				west = c + 1
+			}
+		}
+	}
+}
+
+func (p platform) tiltSouth() {
+	spots := p.Cells()
+	for c := p.Columns() - 1; c >= 0; c-- {
+		south := p.Rows() - 1
+		for r := p.Rows() - 1; r >= 0; r-- {
+			i := r*p.Columns() + c
+			switch spots[i] {
+			case 'O':
+				j := south*p.Columns() + c
+				spots[i], spots[j] = spots[j], spots[i]
+				south--
+			case '#':
+				south = r - 1
+			}
+		}
+	}
+}
+
+func (p platform) tiltEast() {
+	spots := p.Cells()
+	for r := p.Rows() - 1; r >= 0; r-- {
+		east := p.Columns() - 1
+		for c := p.Columns() - 1; c >= 0; c-- {
+			i := r*p.Columns() + c
+			switch spots[i] {
+			case 'O':
+				j := r*p.Columns() + east
+				spots[i], spots[j] = spots[j], spots[i]
+				east--
+			case '#':
+				east = c - 1
+			}
+		}
+	}
+}
+
+func (d Day14b) Part1(ctx context.Context) (day.Answer, error) {
+	lines, err := d.ReadGrid(ctx, ".#O")
+	if err != nil {
+		return day.Answer{}, err
+	}
+
+	p := makePlatform(lines)
+	p.tiltNorth()
+
+	return day.Int(p.load()), nil
+}
+
+func (p platform) load() int {
+	result := 0
+	for r, l := 0, p.Rows(); r < p.Rows()*p.Columns(); r, l = r+p.Columns(), l-1 {
+		for _, spot := range p.Cells()[r : r+p.Columns()] {
+			if spot == 'O' {
+				result += l
+			}
+		}
+	}
+	return result
+}
+
+func (p *platform) cycle() {
+	p.tiltNorth()
+	p.tiltWest()
+	p.tiltSouth()
+	p.tiltEast()
+}
+
+// detectLoop spins p until its spots repeat, telling states apart by their
+// hash. It returns the loop and the load after each spin cycle up to it.
+func (p *platform) detectLoop(ctx context.Context) (cycle.Cycle, []int, error) {
+	loads := make([]int, 0)
+	d := cycle.NewDetector[uint64]()
+	for {
+		if err := ctx.Err(); err != nil {
+			return cycle.Cycle{}, nil, err
+		}
+		if c, ok := d.Add(xxhash.Sum64(p.Cells())); ok {
+			return c, loads, nil
+		}
+		loads = append(loads, p.load())
+		p.cycle()
+	}
+}
+
+func makePlatform(lines []string) platform {
+	return platform{grid.Bytes(lines)}
+}
+
+func (d Day14b) Part2(ctx context.Context) (day.Answer, error) {
+	lines, err := d.ReadGrid(ctx, ".#O")
+	if err != nil {
+		return day.Answer{}, err
+	}
+
+	p := makePlatform(lines)
+
+	c, loads, err := p.detectLoop(ctx)
+	if err != nil {
+		return day.Answer{}, err
+	}
+
+	return day.Int(loads[c.Index(1_000_000_000)]), nil
+}
+
+func init() {
+	day.Register(day.Solver{
+		Day:     14,
+		Variant: "b",
+		New:     func(inputFile string) day.Day { return NewDay14b(inputFile) },
+	})
+}
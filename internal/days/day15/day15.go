@@ -0,0 +1,180 @@
+package day15
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"adventofcode23/internal/day"
+)
+
+type Day15 struct {
+	day.DayInput
+}
+
+type lens struct {
+	label    string
+	focalLen int
+	loc      int
+}
+
+// operation removes the lens with label from its box when focalLen is 0, and
+// adds or replaces it otherwise.
+type operation struct {
+	label    string
+	focalLen int
+}
+
+type box struct {
+	lenses map[string]*lens
+	order  []*lens
+}
+
+func NewDay15(inputFile string) Day15 {
+	return Day15{day.NewInput(inputFile)}
+}
+
+func newBox() box {
+	lenses := make(map[string]*lens)
+	order := make([]*lens, 0)
+	return box{lenses, order}
+}
+
+func (b *box) add(label string, focalLen int) {
+	if v, ok := b.lenses[label]; ok {
+		// lens already present
+		v.focalLen = focalLen
+		return
+	}
+	loc := len(b.order)
+	l := lens{label, focalLen, loc}
+	b.lenses[label] = &l
+	b.order = append(b.order, &l)
+}
+
+func (b *box) delete(label string) {
+	if _, ok := b.lenses[label]; !ok {
+		// lens already not present
+		return
+	}
+	loc := b.lenses[label].loc
+	b.order = append(b.order[:loc], b.order[loc+1:]...)
+	for i := loc; i < len(b.order); i++ {
+		b.order[i].loc--
+	}
+	delete(b.lenses, label)
+}
+
+func (b box) power() int {
+	sum := 0
+	for i := range b.order {
+		l := b.order[i]
+		sum += (i + 1) * l.focalLen
+	}
+	return sum
+}
+
+func HASH(s string) int {
+	result := 0
+	for _, c := range s {
+		result += int(c)
+		result *= 17
+		result %= 256
+	}
+	return result
+}
+
+func (d Day15) Part1(ctx context.Context) (day.Answer, error) {
+	steps, err := day.Parse(ctx, d.DayInput, parseSteps)
+	if err != nil {
+		return day.Answer{}, err
+	}
+
+	sum := 0
+	for _, step := range steps {
+		sum += HASH(step)
+	}
+	return day.Int(sum), nil
+}
+
+func parseSteps(lines []string) ([]string, error) {
+	if len(lines) == 0 || len(lines[0]) == 0 {
+		return nil, day.Locate(errors.New("empty initialization sequence"), 1)
+	}
+	return strings.Split(lines[0], ","), nil
+}
+
+func parseOperation(step string, column int) (operation, error) {
+	if label, f, ok := strings.Cut(step, "="); ok {
+		focalLen, err := day.Atoi(f, column+len(label)+1)
+		if err != nil {
+			return operation{}, err
+		}
+		if focalLen < 1 {
+			return operation{}, day.Errorf(column+len(label)+1, "invalid focal length %d", focalLen)
+		}
+		return operation{label, focalLen}, nil
+	}
+	label, ok := strings.CutSuffix(step, "-")
+	if !ok {
+		return operation{}, day.Errorf(column, "invalid step %q", step)
+	}
+	return operation{label, 0}, nil
+}
+
+func parseOperations(lines []string) ([]operation, error) {
+	steps, err := parseSteps(lines)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]operation, len(steps))
+	column := 1
+	for i, step := range steps {
+		op, err := parseOperation(step, column)
+		if err != nil {
+			return nil, day.Locate(err, 1)
+		}
+		result[i] = op
+		column += len(step) + 1
+	}
+	return result, nil
+}
+
+func perform(op operation, boxes []box) {
+	boxID := HASH(op.label)
+	if op.focalLen > 0 {
+		boxes[boxID].add(op.label, op.focalLen)
+	} else {
+		boxes[boxID].delete(op.label)
+	}
+}
+
+func (d Day15) Part2(ctx context.Context) (day.Answer, error) {
+	operations, err := day.Parse(ctx, d.DayInput, parseOperations)
+	if err != nil {
+		return day.Answer{}, err
+	}
+
+	boxes := make([]box, 256)
+	for i := range boxes {
+		boxes[i] = newBox()
+	}
+
+	for _, op := range operations {
+		perform(op, boxes)
+	}
+
+	sum := 0
+	for i, b := range boxes {
+		sum += (i + 1) * b.power()
+	}
+	return day.Int(sum), nil
+}
+
+func init() {
+	day.Register(day.Solver{
+		Day: 15,
+		New: func(inputFile string) day.Day { return NewDay15(inputFile) },
+	})
+}
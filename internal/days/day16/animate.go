@@ -0,0 +1,37 @@
+package day16
+
+import (
+	"context"
+
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/grid"
+	"adventofcode23/internal/render"
+)
+
+var _ day.Animator = Day16{}
+
+// Animate shows the beam spreading through the contraption one tile at a
+// time, with the tile it just reached marked.
+func (d Day16) Animate(ctx context.Context, part int, record func(*render.Picture)) error {
+	c, start, heading, err := d.start(ctx, part)
+	if err != nil {
+		return err
+	}
+
+	picture := c.picture()
+	record(picture)
+	var previous *grid.Point
+	c.energize(start, heading, func(p grid.Point) {
+		if previous != nil {
+			picture.Paint(previous.Row, previous.Column, render.Highlight)
+		}
+		picture.Paint(p.Row, p.Column, render.Path)
+		previous = &p
+		record(picture)
+	})
+	if previous != nil {
+		picture.Paint(previous.Row, previous.Column, render.Highlight)
+		record(picture)
+	}
+	return nil
+}
@@ -0,0 +1,177 @@
+package day16
+
+import (
+	"context"
+
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/grid"
+	"adventofcode23/internal/parallel"
+)
+
+type Day16 struct {
+	day.DayInput
+}
+
+// tile records the headings of the beams that passed through it.
+type tile uint8
+
+type contraption struct {
+	grid.Grid[byte]
+}
+
+// bounceMap gives the headings a beam leaves a tile with, by the heading it
+// enters with.
+var bounceMap = map[byte]map[grid.Direction][]grid.Direction{
+	'.': {
+		grid.North: {grid.North},
+		grid.East:  {grid.East},
+		grid.South: {grid.South},
+		grid.West:  {grid.West},
+	},
+	'/': {
+		grid.North: {grid.East},
+		grid.East:  {grid.North},
+		grid.South: {grid.West},
+		grid.West:  {grid.South},
+	},
+	'\\': {
+		grid.North: {grid.West},
+		grid.East:  {grid.South},
+		grid.South: {grid.East},
+		grid.West:  {grid.North},
+	},
+	'|': {
+		grid.North: {grid.North},
+		grid.East:  {grid.North, grid.South},
+		grid.South: {grid.South},
+		grid.West:  {grid.North, grid.South},
+	},
+	'-': {
+		grid.North: {grid.East, grid.West},
+		grid.East:  {grid.East},
+		grid.South: {grid.East, grid.West},
+		grid.West:  {grid.West},
+	},
+}
+
+func (t tile) isEnergized() bool {
+	return t != 0
+}
+
+func (t tile) seen(heading grid.Direction) bool {
+	return t&(1<<heading) != 0
+}
+
+func NewDay16(inputFile string) Day16 {
+	return Day16{day.NewInput(inputFile)}
+}
+
+// beam follows a beam entering p with the given heading, marking the tiles it
+// passes. When lit is set, it is called for every tile newly passed.
+func (c contraption) beam(tiles grid.Grid[tile], p grid.Point, heading grid.Direction, lit func(grid.Point)) {
+	if !c.In(p) {
+		// beam leaves the contraption
+		return
+	}
+	t := tiles.Get(p)
+	if t.seen(heading) {
+		return
+	}
+
+	tiles.Set(p, t|1<<heading)
+	if lit != nil {
+		lit(p)
+	}
+	for _, h := range bounceMap[c.Get(p)][heading] {
+		c.beam(tiles, p.Move(h), h, lit)
+	}
+}
+
+// energize returns the tiles the beam passes through when it enters at start
+// with the given heading. lit is handed on to beam.
+func (c contraption) energize(start grid.Point, heading grid.Direction, lit func(grid.Point)) grid.Grid[tile] {
+	tiles := grid.New[tile](c.Rows(), c.Columns())
+	c.beam(tiles, start, heading, lit)
+	return tiles
+}
+
+func (c contraption) countEnergized(start grid.Point, heading grid.Direction) int {
+	result := 0
+	for _, t := range c.energize(start, heading, nil).Cells() {
+		if t.isEnergized() {
+			result++
+		}
+	}
+
+	return result
+}
+
+func (d Day16) Part1(ctx context.Context) (day.Answer, error) {
+	lines, err := d.ReadGrid(ctx, `./\|-`)
+	if err != nil {
+		return day.Answer{}, err
+	}
+	c := contraption{grid.Bytes(lines)}
+
+	return day.Int(c.countEnergized(grid.Point{}, grid.East)), nil
+}
+
+func (d Day16) Part2(ctx context.Context) (day.Answer, error) {
+	lines, err := d.ReadGrid(ctx, `./\|-`)
+	if err != nil {
+		return day.Answer{}, err
+	}
+	c := contraption{grid.Bytes(lines)}
+
+	_, _, energized, err := c.best(ctx)
+	if err != nil {
+		return day.Answer{}, err
+	}
+	return day.Int(energized), nil
+}
+
+// entry is an edge tile and the heading of a beam entering there.
+type entry struct {
+	start   grid.Point
+	heading grid.Direction
+}
+
+// best returns the edge tile and heading a beam energizes the most tiles
+// from, and their number. The entries are tried in parallel; ties go to the
+// first entry listed, rows before columns, so the result is deterministic.
+func (c contraption) best(ctx context.Context) (grid.Point, grid.Direction, int, error) {
+	entries := make([]entry, 0, 2*(c.Rows()+c.Columns()))
+	last := grid.Point{Row: c.Rows() - 1, Column: c.Columns() - 1}
+	for row := 0; row < c.Rows(); row++ {
+		entries = append(entries,
+			entry{grid.Point{Row: row, Column: 0}, grid.East},
+			entry{grid.Point{Row: row, Column: last.Column}, grid.West})
+	}
+	for column := 0; column < c.Columns(); column++ {
+		entries = append(entries,
+			entry{grid.Point{Row: 0, Column: column}, grid.South},
+			entry{grid.Point{Row: last.Row, Column: column}, grid.North})
+	}
+
+	counts, err := parallel.Map(ctx, entries, func(e entry) int {
+		return c.countEnergized(e.start, e.heading)
+	})
+	if err != nil {
+		return grid.Point{}, 0, 0, err
+	}
+
+	best := 0
+	for i, n := range counts {
+		if n > counts[best] {
+			best = i
+		}
+	}
+	return entries[best].start, entries[best].heading, counts[best], nil
+}
+
+func init() {
+	day.Register(day.Solver{
+		Day: 16,
+		New: func(inputFile string) day.Day { return NewDay16(inputFile) },
+	})
+}
@@ -0,0 +1,55 @@
+package day16
+
+import (
+	"context"
+
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/grid"
+	"adventofcode23/internal/render"
+)
+
+var _ day.Renderer = Day16{}
+
+// start returns the contraption and where the beam of part enters it, for
+// part 2 the start that energizes the most tiles.
+func (d Day16) start(ctx context.Context, part int) (contraption, grid.Point, grid.Direction, error) {
+	lines, err := d.ReadGrid(ctx, `./\|-`)
+	if err != nil {
+		return contraption{}, grid.Point{}, 0, err
+	}
+	c := contraption{grid.Bytes(lines)}
+
+	if part == 2 {
+		start, heading, _, err := c.best(ctx)
+		return c, start, heading, err
+	}
+	return c, grid.Point{}, grid.East, nil
+}
+
+func (c contraption) picture() *render.Picture {
+	result := render.New(c.Rows(), c.Columns())
+	for r := 0; r < c.Rows(); r++ {
+		result.SetText(r, 0, c.Row(r))
+	}
+	return result
+}
+
+// Render draws the energized tiles, for part 2 those of the best start.
+func (d Day16) Render(ctx context.Context, part int) (*render.Picture, error) {
+	c, start, heading, err := d.start(ctx, part)
+	if err != nil {
+		return nil, err
+	}
+
+	result := c.picture()
+	tiles := c.energize(start, heading, nil)
+	for r := 0; r < c.Rows(); r++ {
+		for col, t := range tiles.Row(r) {
+			if t.isEnergized() {
+				result.Paint(r, col, render.Highlight)
+			}
+		}
+	}
+	result.Paint(start.Row, start.Column, render.Start)
+	return result, nil
+}
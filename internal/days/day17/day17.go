@@ -0,0 +1,64 @@
+package day17
+
+import (
+	"context"
+
+	"adventofcode23/internal/crucible"
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/grid"
+)
+
+type Day17 struct {
+	day.DayInput
+}
+
+func NewDay17(inputFile string) Day17 {
+	return Day17{day.NewInput(inputFile)}
+}
+
+// rules returns the crucible of a part.
+func rules(part int) crucible.Rules {
+	if part == 2 {
+		return crucible.Ultra
+	}
+	return crucible.Crucible
+}
+
+func corner(m crucible.Map) grid.Point {
+	return grid.Point{Row: m.Rows() - 1, Column: m.Columns() - 1}
+}
+
+// route returns the heat map and the route of least heat loss of a part,
+// from the top left to the bottom right block.
+func (d Day17) route(ctx context.Context, part int) (crucible.Map, crucible.Route, error) {
+	lines, err := d.ReadGrid(ctx, "0123456789")
+	if err != nil {
+		return crucible.Map{}, crucible.Route{}, err
+	}
+	m := crucible.NewMap(lines)
+	route, err := m.Dijkstra(ctx, rules(part), grid.Point{}, corner(m))
+	return m, route, err
+}
+
+func (d Day17) Part1(ctx context.Context) (day.Answer, error) {
+	_, route, err := d.route(ctx, 1)
+	if err != nil {
+		return day.Answer{}, err
+	}
+	return day.Int(route.Loss), nil
+}
+
+func (d Day17) Part2(ctx context.Context) (day.Answer, error) {
+	_, route, err := d.route(ctx, 2)
+	if err != nil {
+		return day.Answer{}, err
+	}
+	return day.Int(route.Loss), nil
+}
+
+func init() {
+	day.Register(day.Solver{
+		Day: 17,
+		New: func(inputFile string) day.Day { return NewDay17(inputFile) },
+	})
+}
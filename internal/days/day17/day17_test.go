@@ -0,0 +1,87 @@
+package day17
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"adventofcode23/internal/crucible"
+	"adventofcode23/internal/day/daytest"
+	"adventofcode23/internal/grid"
+)
+
+func TestExamples(t *testing.T) {
+	t.Parallel()
+	daytest.RunExamples(t)
+}
+
+func TestUnreachable(t *testing.T) {
+	t.Parallel()
+	// an ultra crucible cannot stop after fewer than four blocks
+	input := filepath.Join(t.TempDir(), "input.txt")
+	if err := os.WriteFile(input, []byte("11\n11\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := NewDay17(input).Part2(context.Background())
+	if !errors.Is(err, crucible.ErrUnreachable) {
+		t.Errorf("want %v, got %v", crucible.ErrUnreachable, err)
+	}
+}
+
+func BenchmarkParts(b *testing.B) {
+	daytest.BenchmarkParts(b)
+}
+
+func heatMap(b *testing.B) crucible.Map {
+	lines, err := NewDay17(daytest.Input(b, 2)).ReadGrid(context.Background(), "0123456789")
+	if err != nil {
+		b.Fatal(err)
+	}
+	return crucible.NewMap(lines)
+}
+
+// BenchmarkDijkstra times the bucket queue of graph.Dijkstra on a network
+// built beforehand, and BenchmarkNetwork building it.
+func BenchmarkDijkstra(b *testing.B) {
+	m := heatMap(b)
+	n, err := m.Network(crucible.Ultra)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := n.Dijkstra(context.Background(), grid.Point{}, corner(m)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkNetwork(b *testing.B) {
+	m := heatMap(b)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := m.Network(crucible.Ultra); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkAStar times the A* search, which needs no network.
+func BenchmarkAStar(b *testing.B) {
+	m := heatMap(b)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := m.AStar(context.Background(), crucible.Ultra, grid.Point{}, corner(m)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
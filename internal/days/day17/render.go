@@ -0,0 +1,32 @@
+package day17
+
+import (
+	"context"
+
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/render"
+)
+
+var _ day.Renderer = Day17{}
+
+// Render draws the route of least heat loss over the heat map.
+func (d Day17) Render(ctx context.Context, part int) (*render.Picture, error) {
+	m, route, err := d.route(ctx, part)
+	if err != nil {
+		return nil, err
+	}
+
+	result := render.New(m.Rows(), m.Columns())
+	for r := 0; r < m.Rows(); r++ {
+		line := make([]byte, m.Columns())
+		for c, heat := range m.Row(r) {
+			line[c] = byte('0' + heat)
+		}
+		result.SetText(r, 0, line)
+	}
+	for _, p := range route.Blocks() {
+		result.Paint(p.Row, p.Column, render.Path)
+	}
+	result.Paint(0, 0, render.Start)
+	return result, nil
+}
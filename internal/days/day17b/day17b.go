@@ -0,0 +1,46 @@
+package day17b
+
+import (
+	"context"
+
+	"adventofcode23/internal/crucible"
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/grid"
+)
+
+type Day17b struct {
+	day.DayInput
+}
+
+func NewDay17b(inputFile string) Day17b {
+	return Day17b{day.NewInput(inputFile)}
+}
+
+func (d Day17b) loss(ctx context.Context, r crucible.Rules) (day.Answer, error) {
+	lines, err := d.ReadGrid(ctx, "0123456789")
+	if err != nil {
+		return day.Answer{}, err
+	}
+	m := crucible.NewMap(lines)
+	route, err := m.AStar(ctx, r, grid.Point{}, grid.Point{Row: m.Rows() - 1, Column: m.Columns() - 1})
+	if err != nil {
+		return day.Answer{}, err
+	}
+	return day.Int(route.Loss), nil
+}
+
+func (d Day17b) Part1(ctx context.Context) (day.Answer, error) {
+	return d.loss(ctx, crucible.Crucible)
+}
+
+func (d Day17b) Part2(ctx context.Context) (day.Answer, error) {
+	return d.loss(ctx, crucible.Ultra)
+}
+
+func init() {
+	day.Register(day.Solver{
+		Day:     17,
+		Variant: "b",
+		New:     func(inputFile string) day.Day { return NewDay17b(inputFile) },
+	})
+}
@@ -0,0 +1,35 @@
+package day17b
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"adventofcode23/internal/crucible"
+	"adventofcode23/internal/day/daytest"
+)
+
+func TestExamples(t *testing.T) {
+	t.Parallel()
+	daytest.RunExamples(t)
+}
+
+func TestUnreachable(t *testing.T) {
+	t.Parallel()
+	// an ultra crucible cannot stop after fewer than four blocks
+	input := filepath.Join(t.TempDir(), "input.txt")
+	if err := os.WriteFile(input, []byte("11\n11\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := NewDay17b(input).Part2(context.Background())
+	if !errors.Is(err, crucible.ErrUnreachable) {
+		t.Errorf("want %v, got %v", crucible.ErrUnreachable, err)
+	}
+}
+
+func BenchmarkParts(b *testing.B) {
+	daytest.BenchmarkParts(b)
+}
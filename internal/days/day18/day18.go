@@ -0,0 +1,275 @@
+package day18
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"adventofcode23/internal/day"
+
+	"golang.org/x/exp/maps"
+)
+
+type Day18 struct {
+	day.DayInput
+}
+
+func NewDay18(inputFile string) Day18 {
+	return Day18{day.NewInput(inputFile)}
+}
+
+type action struct {
+	direction    byte
+	steps        int
+	start, compr coord
+	corner, edge byte
+}
+
+type terrain [][]byte
+
+type turn struct {
+	dRow, dColumn int
+	corner, edge  byte
+}
+
+type coord struct {
+	row, column int
+}
+
+var directionMap = map[string]byte{
+	"U": 'U',
+	"R": 'R',
+	"D": 'D',
+	"L": 'L',
+	"0": 'R',
+	"1": 'D',
+	"2": 'L',
+	"3": 'U',
+}
+
+var turnMap = map[[2]byte]turn{
+	{'U', 'R'}: {0, 1, 'F', '-'},
+	{'U', 'L'}: {0, -1, '7', '-'},
+	{'R', 'D'}: {1, 0, '7', '|'},
+	{'R', 'U'}: {-1, 0, 'J', '|'},
+	{'D', 'L'}: {0, -1, 'J', '-'},
+	{'D', 'R'}: {0, 1, 'L', '-'},
+	{'L', 'U'}: {-1, 0, 'L', '|'},
+	{'L', 'D'}: {1, 0, 'F', '|'},
+}
+
+func parseAction1(line string) (action, error) {
+	fields := strings.Fields(line)
+	if len(fields) != 3 {
+		return action{}, day.Errorf(1, "want direction, steps and color")
+	}
+	if len(fields[0]) != 1 || !strings.Contains("URDL", fields[0]) {
+		return action{}, day.Errorf(1, "invalid direction %q", fields[0])
+	}
+	s, err := day.Atoi(fields[1], len(fields[0])+2)
+	if err != nil {
+		return action{}, err
+	}
+	return action{
+		direction: directionMap[fields[0]],
+		steps:     s,
+	}, nil
+}
+
+func parseAction2(line string) (action, error) {
+	fields := strings.Fields(line)
+	if len(fields) != 3 {
+		return action{}, day.Errorf(1, "want direction, steps and color")
+	}
+	column := len(fields[0]) + len(fields[1]) + 3
+	color := fields[2]
+	if len(color) != 9 || !strings.HasPrefix(color, "(#") || !strings.HasSuffix(color, ")") {
+		return action{}, day.Errorf(column, "invalid color %q", color)
+	}
+	if !strings.Contains("0123", color[7:8]) {
+		return action{}, day.Errorf(column+7, "invalid direction %q", color[7])
+	}
+	hexSteps, err := strconv.ParseInt(color[2:7], 16, 64)
+	if err != nil {
+		return action{}, day.Errorf(column+2, "invalid hexadecimal steps %q", color[2:7])
+	}
+	return action{
+		direction: directionMap[color[7:8]],
+		steps:     int(hexSteps),
+	}, nil
+}
+
+func makePlan(lines []string, parse func(string) (action, error)) ([]action, error) {
+	if len(lines) == 0 {
+		return nil, day.Locate(errors.New("empty dig plan"), 1)
+	}
+
+	result := make([]action, len(lines))
+	for i, line := range lines {
+		a, err := parse(line)
+		if err != nil {
+			return nil, day.Locate(err, i+1)
+		}
+		result[i] = a
+	}
+
+	prevDirection := result[len(result)-1].direction
+	for i, a := range result {
+		if _, ok := turnMap[[2]byte{prevDirection, a.direction}]; !ok {
+			err := fmt.Errorf("cannot turn from %c to %c", prevDirection, a.direction)
+			return nil, day.Locate(err, i+1)
+		}
+		prevDirection = a.direction
+	}
+
+	return result, nil
+}
+
+func makePlan1(lines []string) ([]action, error) {
+	return makePlan(lines, parseAction1)
+}
+
+func makePlan2(lines []string) ([]action, error) {
+	return makePlan(lines, parseAction2)
+}
+func addCoords(plan []action) {
+	r := 0
+	c := 0
+	prevDirection := plan[len(plan)-1].direction
+	for i, a := range plan {
+		turn := turnMap[[2]byte{prevDirection, a.direction}]
+		prevDirection = a.direction
+		plan[i].corner, plan[i].edge = turn.corner, turn.edge
+		plan[i].start = coord{r, c}
+		r, c = r+a.steps*turn.dRow, c+a.steps*turn.dColumn
+	}
+}
+
+func compressionMap(m map[int]struct{}) (map[int]int, []int) {
+	n := maps.Keys(m)
+	sort.Ints(n)
+	translations := make(map[int]int, len(n))
+
+	for i, j := range n {
+		translations[j] = i
+	}
+	widths := make([]int, len(n)-1)
+	for i, j := 0, 1; i < len(widths); i, j = i+1, j+1 {
+		widths[i] = abs(n[j] - n[i])
+	}
+	return translations, widths
+}
+
+func addComprCoords(plan []action) ([]int, []int) {
+	rows := make(map[int]struct{})
+	columns := make(map[int]struct{})
+	for _, a := range plan {
+		rows[a.start.row] = struct{}{}
+		rows[a.start.row+1] = struct{}{}
+		columns[a.start.column] = struct{}{}
+		columns[a.start.column+1] = struct{}{}
+	}
+
+	comprRows, rowWidths := compressionMap(rows)
+	comprColumns, columnWidths := compressionMap(columns)
+
+	for i, a := range plan {
+		plan[i].compr = coord{comprRows[a.start.row], comprColumns[a.start.column]}
+	}
+
+	return rowWidths, columnWidths
+}
+
+func makeTerrain(rows, columns int) terrain {
+	result := make([][]byte, rows)
+	for i := range result {
+		result[i] = []byte(strings.Repeat(".", columns))
+	}
+	return result
+}
+
+func abs(i int) int {
+	if i < 0 {
+		return -i
+	}
+	return i
+}
+
+func (t terrain) dig(plan []action) {
+	r := plan[0].compr.row
+	c := plan[0].compr.column
+	prevDirection := plan[len(plan)-1].direction
+	for i, j := 0, 1; i < len(plan); i, j = i+1, (j+1)%len(plan) {
+		a := plan[i]
+		b := plan[j]
+		turn := turnMap[[2]byte{prevDirection, a.direction}]
+		prevDirection = a.direction
+		t[r][c] = turn.corner
+		r, c = r+turn.dRow, c+turn.dColumn
+		for !(r == b.compr.row && c == b.compr.column) {
+			t[r][c] = turn.edge
+			r, c = r+turn.dRow, c+turn.dColumn
+		}
+	}
+}
+
+func (t terrain) isInside(row, column int) bool {
+	inside := false
+	m := min(row, column)
+	for i := 0; i < m; i++ {
+		v := t[row-1-i][column-1-i]
+		if v == '|' || v == '-' || v == 'J' || v == 'F' {
+			inside = !inside
+		}
+	}
+
+	return inside
+}
+
+func (t terrain) countDugOut(rows, columns []int) int {
+	result := 0
+	for i := range t {
+		for j := range t[i] {
+			if t[i][j] != '.' || t.isInside(i, j) {
+				result += rows[i] * columns[j]
+			}
+		}
+	}
+	return result
+}
+
+func (d Day18) Part1(ctx context.Context) (day.Answer, error) {
+	plan, err := day.Parse(ctx, d.DayInput, makePlan1)
+	if err != nil {
+		return day.Answer{}, err
+	}
+	addCoords(plan)
+	rows, columns := addComprCoords(plan)
+	t := makeTerrain(len(rows), len(columns))
+	t.dig(plan)
+
+	return day.Int(t.countDugOut(rows, columns)), nil
+}
+
+func (d Day18) Part2(ctx context.Context) (day.Answer, error) {
+	plan, err := day.Parse(ctx, d.DayInput, makePlan2)
+	if err != nil {
+		return day.Answer{}, err
+	}
+	addCoords(plan)
+	rows, columns := addComprCoords(plan)
+	t := makeTerrain(len(rows), len(columns))
+	t.dig(plan)
+
+	return day.Int(t.countDugOut(rows, columns)), nil
+}
+
+func init() {
+	day.Register(day.Solver{
+		Day: 18,
+		New: func(inputFile string) day.Day { return NewDay18(inputFile) },
+	})
+}
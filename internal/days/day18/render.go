@@ -0,0 +1,40 @@
+package day18
+
+import (
+	"context"
+
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/render"
+)
+
+var _ day.Renderer = Day18{}
+
+// Render draws the lagoon on the compressed terrain, where every row and
+// column stands for a band of rows or columns of the real one.
+func (d Day18) Render(ctx context.Context, part int) (*render.Picture, error) {
+	makePlan := makePlan1
+	if part == 2 {
+		makePlan = makePlan2
+	}
+	plan, err := day.Parse(ctx, d.DayInput, makePlan)
+	if err != nil {
+		return nil, err
+	}
+	addCoords(plan)
+	rows, columns := addComprCoords(plan)
+	t := makeTerrain(len(rows), len(columns))
+	t.dig(plan)
+
+	result := render.FromText(t)
+	for i := range t {
+		for j := range t[i] {
+			switch {
+			case t[i][j] != '.':
+				result.Paint(i, j, render.Path)
+			case t.isInside(i, j):
+				result.Paint(i, j, render.Fill)
+			}
+		}
+	}
+	return result, nil
+}
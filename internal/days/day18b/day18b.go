@@ -0,0 +1,180 @@
+package day18b
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/grid"
+	"adventofcode23/internal/mathx"
+)
+
+type Day18b struct {
+	day.DayInput
+}
+
+func NewDay18b(inputFile string) Day18b {
+	return Day18b{day.NewInput(inputFile)}
+}
+
+type action struct {
+	direction byte
+	steps     int
+}
+
+type plan []action
+
+type turn struct {
+	dRow, dColumn int
+	corner, edge  byte
+}
+
+type polygon []grid.Point
+
+var directionMap = map[string]byte{
+	"U": 'U',
+	"R": 'R',
+	"D": 'D',
+	"L": 'L',
+	"0": 'R',
+	"1": 'D',
+	"2": 'L',
+	"3": 'U',
+}
+
+var turnMap = map[[2]byte]turn{
+	{'U', 'R'}: {0, 1, 'F', '-'},
+	{'U', 'L'}: {0, -1, '7', '-'},
+	{'R', 'D'}: {1, 0, '7', '|'},
+	{'R', 'U'}: {-1, 0, 'J', '|'},
+	{'D', 'L'}: {0, -1, 'J', '-'},
+	{'D', 'R'}: {0, 1, 'L', '-'},
+	{'L', 'U'}: {-1, 0, 'L', '|'},
+	{'L', 'D'}: {1, 0, 'F', '|'},
+}
+
+func parseAction1(line string) (action, error) {
+	fields := strings.Fields(line)
+	if len(fields) != 3 {
+		return action{}, day.Errorf(1, "want direction, steps and color")
+	}
+	if len(fields[0]) != 1 || !strings.Contains("URDL", fields[0]) {
+		return action{}, day.Errorf(1, "invalid direction %q", fields[0])
+	}
+	s, err := day.Atoi(fields[1], len(fields[0])+2)
+	if err != nil {
+		return action{}, err
+	}
+	return action{
+		direction: directionMap[fields[0]],
+		steps:     s,
+	}, nil
+}
+
+func parseAction2(line string) (action, error) {
+	fields := strings.Fields(line)
+	if len(fields) != 3 {
+		return action{}, day.Errorf(1, "want direction, steps and color")
+	}
+	column := len(fields[0]) + len(fields[1]) + 3
+	color := fields[2]
+	if len(color) != 9 || !strings.HasPrefix(color, "(#") || !strings.HasSuffix(color, ")") {
+		return action{}, day.Errorf(column, "invalid color %q", color)
+	}
+	if !strings.Contains("0123", color[7:8]) {
+		return action{}, day.Errorf(column+7, "invalid direction %q", color[7])
+	}
+	hexSteps, err := strconv.ParseInt(color[2:7], 16, 64)
+	if err != nil {
+		return action{}, day.Errorf(column+2, "invalid hexadecimal steps %q", color[2:7])
+	}
+	return action{
+		direction: directionMap[color[7:8]],
+		steps:     int(hexSteps),
+	}, nil
+}
+
+func makePlan(lines []string, parse func(string) (action, error)) (plan, error) {
+	if len(lines) == 0 {
+		return nil, day.Locate(errors.New("empty dig plan"), 1)
+	}
+
+	result := make(plan, len(lines))
+	for i, line := range lines {
+		a, err := parse(line)
+		if err != nil {
+			return nil, day.Locate(err, i+1)
+		}
+		result[i] = a
+	}
+
+	prevDirection := result[len(result)-1].direction
+	for i, a := range result {
+		if _, ok := turnMap[[2]byte{prevDirection, a.direction}]; !ok {
+			err := fmt.Errorf("cannot turn from %c to %c", prevDirection, a.direction)
+			return nil, day.Locate(err, i+1)
+		}
+		prevDirection = a.direction
+	}
+
+	return result, nil
+}
+
+func makePlan1(lines []string) (plan, error) {
+	return makePlan(lines, parseAction1)
+}
+
+func makePlan2(lines []string) (plan, error) {
+	return makePlan(lines, parseAction2)
+}
+
+func (p plan) makePolygon() polygon {
+	result := make(polygon, len(p))
+	row, column := 0, 0
+
+	prevDirection := p[len(p)-1].direction
+	for i, a := range p {
+		turn := turnMap[[2]byte{prevDirection, a.direction}]
+		prevDirection = a.direction
+		result[i] = grid.Point{Row: row, Column: column}
+		row, column = row+a.steps*turn.dRow, column+a.steps*turn.dColumn
+	}
+
+	return result
+}
+
+func (p polygon) capacity() int {
+	// the trench itself plus the lattice points inside it
+	return mathx.BoundaryPoints(p) + mathx.InteriorPoints(p)
+}
+
+func (d Day18b) Part1(ctx context.Context) (day.Answer, error) {
+	plan, err := day.Parse(ctx, d.DayInput, makePlan1)
+	if err != nil {
+		return day.Answer{}, err
+	}
+	polygon := plan.makePolygon()
+
+	return day.Int(polygon.capacity()), nil
+}
+
+func (d Day18b) Part2(ctx context.Context) (day.Answer, error) {
+	plan, err := day.Parse(ctx, d.DayInput, makePlan2)
+	if err != nil {
+		return day.Answer{}, err
+	}
+	polygon := plan.makePolygon()
+
+	return day.Int(polygon.capacity()), nil
+}
+
+func init() {
+	day.Register(day.Solver{
+		Day:     18,
+		Variant: "b",
+		New:     func(inputFile string) day.Day { return NewDay18b(inputFile) },
+	})
+}
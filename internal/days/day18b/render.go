@@ -0,0 +1,78 @@
+package day18b
+
+import (
+	"context"
+
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/grid"
+	"adventofcode23/internal/render"
+)
+
+var _ day.Renderer = Day18b{}
+
+// maxCells bounds the rows and columns of the pictures; larger lagoons are
+// scaled down.
+const maxCells = 256
+
+// Render draws the lagoon, scaled down to at most maxCells rows and columns.
+// A cell is filled when its center lies inside the trench.
+func (d Day18b) Render(ctx context.Context, part int) (*render.Picture, error) {
+	makePlan := makePlan1
+	if part == 2 {
+		makePlan = makePlan2
+	}
+	plan, err := day.Parse(ctx, d.DayInput, makePlan)
+	if err != nil {
+		return nil, err
+	}
+	polygon := plan.makePolygon()
+
+	low, high := polygon[0], polygon[0]
+	for _, p := range polygon {
+		low = grid.Point{Row: min(low.Row, p.Row), Column: min(low.Column, p.Column)}
+		high = grid.Point{Row: max(high.Row, p.Row), Column: max(high.Column, p.Column)}
+	}
+	size := high.Sub(low).Add(grid.Point{Row: 1, Column: 1})
+	scale := max(1, (max(size.Row, size.Column)+maxCells-1)/maxCells)
+	cell := func(p grid.Point) grid.Point {
+		p = p.Sub(low)
+		return grid.Point{Row: p.Row / scale, Column: p.Column / scale}
+	}
+
+	last := cell(high)
+	result := render.New(last.Row+1, last.Column+1)
+	for r := 0; r <= last.Row; r++ {
+		for c := 0; c <= last.Column; c++ {
+			center := grid.Point{Row: low.Row + r*scale + scale/2, Column: low.Column + c*scale + scale/2}
+			if polygon.contains(center) {
+				result.Set(r, c, render.Cell{Glyph: '#', Color: render.Fill})
+			}
+		}
+	}
+	for i, p := range polygon {
+		from, to := cell(p), cell(polygon[(i+1)%len(polygon)])
+		for r := min(from.Row, to.Row); r <= max(from.Row, to.Row); r++ {
+			for c := min(from.Column, to.Column); c <= max(from.Column, to.Column); c++ {
+				result.Set(r, c, render.Cell{Glyph: '#', Color: render.Path})
+			}
+		}
+	}
+	return result, nil
+}
+
+// contains reports whether p lies strictly inside the polygon, counting the
+// vertical edges to its left.
+func (p polygon) contains(point grid.Point) bool {
+	inside := false
+	for i, a := range p {
+		b := p[(i+1)%len(p)]
+		if a.Column != b.Column || a.Column >= point.Column {
+			continue
+		}
+		// half-open, so that a vertex is counted for one of its edges
+		if (a.Row <= point.Row) != (b.Row <= point.Row) {
+			inside = !inside
+		}
+	}
+	return inside
+}
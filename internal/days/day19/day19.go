@@ -0,0 +1,83 @@
+package day19
+
+import (
+	"context"
+	"slices"
+
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/workflow"
+)
+
+type Day19 struct {
+	day.DayInput
+}
+
+func NewDay19(inputFile string) Day19 {
+	return Day19{day.NewInput(inputFile)}
+}
+
+const (
+	minRating = 1
+	maxRating = 4000
+)
+
+// categories are the categories every part is rated in.
+var categories = []string{"x", "m", "a", "s"}
+
+// system returns the workflows and parts, parsed once for both parts.
+func (d Day19) system(ctx context.Context) (*workflow.System, error) {
+	return day.Once(d.DayInput, "system", func() (*workflow.System, error) {
+		return day.Parse(ctx, d.DayInput, workflow.Parse)
+	})
+}
+
+// bounds returns all possible ratings, in the categories of every part and
+// in any others the workflows use.
+func bounds(s *workflow.System) workflow.Box {
+	all := slices.Clone(categories)
+	for _, c := range s.Categories() {
+		if !slices.Contains(all, c) {
+			all = append(all, c)
+		}
+	}
+	return workflow.Bounds(all, minRating, maxRating)
+}
+
+func (d Day19) Part1(ctx context.Context) (day.Answer, error) {
+	s, err := d.system(ctx)
+	if err != nil {
+		return day.Answer{}, err
+	}
+
+	sum := 0
+	for _, p := range s.Parts {
+		ok, err := s.Accepts(p)
+		if err != nil {
+			return day.Answer{}, err
+		}
+		if ok {
+			sum += p.Sum()
+		}
+	}
+	return day.Int(sum), nil
+}
+
+func (d Day19) Part2(ctx context.Context) (day.Answer, error) {
+	s, err := d.system(ctx)
+	if err != nil {
+		return day.Answer{}, err
+	}
+
+	count, err := s.Count(bounds(s))
+	if err != nil {
+		return day.Answer{}, err
+	}
+	return day.Int(count), nil
+}
+
+func init() {
+	day.Register(day.Solver{
+		Day: 19,
+		New: func(inputFile string) day.Day { return NewDay19(inputFile) },
+	})
+}
@@ -0,0 +1,54 @@
+package day19
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/day/daytest"
+)
+
+func TestExamples(t *testing.T) {
+	t.Parallel()
+	daytest.RunExamples(t)
+}
+
+func BenchmarkParts(b *testing.B) {
+	daytest.BenchmarkParts(b)
+}
+
+func writeInput(t *testing.T, input string) string {
+	t.Helper()
+	file := filepath.Join(t.TempDir(), "input.txt")
+	if err := os.WriteFile(file, []byte(input), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return file
+}
+
+func TestOtherCategories(t *testing.T) {
+	t.Parallel()
+	d := NewDay19(writeInput(t, "in{speed>3990:A,R}\n\n{x=1,m=1,a=1,s=1,speed=4000}\n"))
+	ctx := context.Background()
+
+	if got, err := d.Part1(ctx); err != nil || got != day.Int(4004) {
+		t.Errorf("part 1: want 4004, got %v, %v", got, err)
+	}
+	if got, err := d.Part2(ctx); err != nil || got != day.Int(10*4000*4000*4000*4000) {
+		t.Errorf("part 2: want %d, got %v, %v", 10*4000*4000*4000*4000, got, err)
+	}
+}
+
+func TestParseErrorPosition(t *testing.T) {
+	t.Parallel()
+	file := writeInput(t, "in{x<5:px,A}\npx{m>10:A}\n")
+
+	_, err := NewDay19(file).Part1(context.Background())
+	var pe *day.ParseError
+	if !errors.As(err, &pe) || pe.File != file || pe.Line != 2 || pe.Column != 10 {
+		t.Errorf("want an error at %s:2:10, got %v", file, err)
+	}
+}
@@ -0,0 +1,77 @@
+package day19
+
+import (
+	"context"
+	"fmt"
+
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/dot"
+	"adventofcode23/internal/mathx"
+	"adventofcode23/internal/workflow"
+)
+
+var _ day.Grapher = Day19{}
+
+// Dot returns the workflows with their rules as edges. In part 1 edges are
+// labelled with the number of parts sent along them, in part 2 with the
+// number of ratings. Rules that nothing takes are dashed.
+func (d Day19) Dot(ctx context.Context, part int) (*dot.Graph, error) {
+	s, err := d.system(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	flows := make(map[workflow.Ref]int)
+	unit := "parts"
+	if part == 1 {
+		for _, p := range s.Parts {
+			route, err := s.Route(p)
+			if err != nil {
+				return nil, err
+			}
+			for _, r := range route {
+				flows[r]++
+			}
+		}
+	} else {
+		unit = "ratings"
+		fits := true
+		err := s.Walk(bounds(s), func(path []workflow.Ref, box workflow.Box) {
+			volume, ok := box.Volume()
+			fits = fits && ok
+			flows[path[len(path)-1]] += volume
+		})
+		if err != nil {
+			return nil, err
+		}
+		if !fits {
+			return nil, mathx.ErrOverflow
+		}
+	}
+
+	g := dot.New("day19", true)
+	g.Set("rankdir", "LR")
+	g.Node(workflow.Start, dot.Start)
+	g.Node(workflow.Accept, dot.End.With(dot.Attrs{"shape": "doublecircle"}))
+	g.Node(workflow.Reject, dot.Attrs{"shape": "doublecircle", "style": "filled", "fillcolor": "lightgrey"})
+
+	for _, name := range s.Names {
+		for i, r := range s.Workflows[name].Rules {
+			condition := "else"
+			if r.Condition != nil {
+				condition = r.Condition.String()
+			}
+			attrs := dot.Attrs{"label": condition}
+			if n := flows[workflow.Ref{Workflow: name, Rule: i}]; n > 0 {
+				attrs["label"] = fmt.Sprintf("%s\n%d %s", condition, n, unit)
+				if r.Target == workflow.Accept {
+					attrs = attrs.With(dot.Highlight)
+				}
+			} else {
+				attrs["style"] = "dashed"
+			}
+			g.Edge(name, r.Target, attrs)
+		}
+	}
+	return g, nil
+}
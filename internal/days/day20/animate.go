@@ -0,0 +1,137 @@
+package day20
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"adventofcode23/internal/circuit"
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/render"
+)
+
+var _ day.Animator = Day20{}
+
+// board shows a machine with one module per row: its name, its type, and
+// the state of its flip-flop or the memory of its conjunction.
+type board struct {
+	picture *render.Picture
+	sim     *circuit.Sim
+	names   []string
+	rows    map[string]int
+	width   int // of the names
+}
+
+var typeGlyphs = map[circuit.Kind]byte{
+	circuit.Output:      ' ',
+	circuit.FlipFlop:    '%',
+	circuit.Conjunction: '&',
+	circuit.Broadcaster: '>',
+}
+
+func newBoard(sim *circuit.Sim) *board {
+	c := sim.Circuit()
+	names := c.Names()
+	width, columns := 0, 0
+	rows := make(map[string]int, len(names))
+	for i, n := range names {
+		rows[n] = i
+		width = max(width, len(n))
+		columns = max(columns, len(c.Sources(n)))
+	}
+
+	b := &board{render.New(len(names), width+3+columns), sim, names, rows, width}
+	for _, n := range names {
+		b.update(n)
+	}
+	return b
+}
+
+// update redraws the state of the module called name.
+func (b *board) update(name string) {
+	r := b.rows[name]
+	state := b.sim.Module(name)
+	b.picture.SetText(r, 0, []byte(fmt.Sprintf("%-*s %c ", b.width, name, typeGlyphs[state.Kind])))
+
+	column := b.width + 3
+	bit := func(on bool) {
+		cell := render.Cell{Glyph: '0'}
+		if on {
+			cell = render.Cell{Glyph: '1', Color: render.Highlight}
+		}
+		b.picture.Set(r, column, cell)
+		column++
+	}
+	switch state.Kind {
+	case circuit.FlipFlop:
+		bit(state.On)
+	case circuit.Conjunction:
+		for _, on := range state.Memory {
+			bit(on)
+		}
+	}
+}
+
+// mark colors the name of a module by the pulse it received, or plain.
+func (b *board) mark(name string, c render.Color) {
+	r := b.rows[name]
+	for i := 0; i < len(name); i++ {
+		b.picture.Paint(r, i, c)
+	}
+}
+
+// Animate shows the pulses of part 1 one by one, coloring the module that
+// receives each pulse by whether it is high or low. For part 2 it shows the
+// machine after every press until the states of the counters loop.
+func (d Day20) Animate(ctx context.Context, part int, record func(*render.Picture)) error {
+	c, err := day.Parse(ctx, d.DayInput, parseLines)
+	if err != nil {
+		return err
+	}
+	sim := circuit.NewSim(c)
+	b := newBoard(sim)
+	record(b.picture)
+
+	if part == 1 {
+		previous := ""
+		sim.Trace(func(p circuit.Pulse) {
+			if previous != "" {
+				b.mark(previous, render.Plain)
+			}
+			color := render.Path
+			if p.High {
+				color = render.Start
+			}
+			b.update(p.Destination)
+			b.mark(p.Destination, color)
+			previous = p.Destination
+			record(b.picture)
+		})
+		for i := 0; i < presses; i++ {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			sim.Press()
+		}
+		return nil
+	}
+
+	feed, counters, err := decompose(c)
+	if errors.Is(err, errNoRx) || errors.Is(err, errUnsupported) {
+		// nothing to follow
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	_, err = followCounters(ctx, sim, feed, counters, func() {
+		for _, n := range b.names {
+			b.update(n)
+		}
+		record(b.picture)
+	})
+	if errors.Is(err, errUnsupported) {
+		return nil
+	}
+	return err
+}
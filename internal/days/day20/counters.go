@@ -0,0 +1,179 @@
+package day20
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"adventofcode23/internal/circuit"
+	"adventofcode23/internal/cycle"
+	"adventofcode23/internal/mathx"
+)
+
+var (
+	errNoRx        = errors.New("no module sends pulses to rx")
+	errNoLowPulse  = errors.New("rx never receives a low pulse")
+	errUnsupported = errors.New("unsupported machine")
+)
+
+// rx is the module that has to receive a low pulse in part 2.
+const rx = "rx"
+
+// counter is a part of the machine that feeds the conjunction before rx on
+// its own: output and the modules its pulses depend on. Counters are
+// followed through the button presses until their state loops.
+type counter struct {
+	output   string
+	modules  []string
+	detector *cycle.Detector[string]
+	loop     cycle.Cycle
+	found    bool
+	high     []int // presses in which output sent a high pulse
+}
+
+// decompose splits the machine into the conjunction feeding rx and one
+// counter per source of it. Part 2 relies on this structure, so decompose
+// reports an error wrapping errUnsupported when the machine lacks it:
+// rx has to have a single source, a conjunction, whose sources share no
+// state.
+func decompose(c *circuit.Circuit) (string, []*counter, error) {
+	if !c.Has(rx) || len(c.Sources(rx)) == 0 {
+		return "", nil, errNoRx
+	}
+	sources := c.Sources(rx)
+	if len(sources) > 1 {
+		return "", nil, fmt.Errorf("%w: rx has %d sources, want 1", errUnsupported, len(sources))
+	}
+	feed := sources[0]
+	if k := c.Kind(feed); k != circuit.Conjunction {
+		return "", nil, fmt.Errorf("%w: %s before rx is a %v, want a conjunction", errUnsupported, feed, k)
+	}
+
+	if len(c.Sources(feed)) == 0 {
+		return "", nil, errNoLowPulse
+	}
+
+	owner := make(map[string]string)
+	counters := make([]*counter, 0)
+	for _, output := range c.Sources(feed) {
+		cone := c.Cone(output)
+		for _, m := range cone {
+			if m == feed {
+				return "", nil, fmt.Errorf("%w: %s feeds back into %s", errUnsupported, feed, output)
+			}
+			if !c.Kind(m).Stateful() {
+				continue
+			}
+			if o, ok := owner[m]; ok {
+				return "", nil, fmt.Errorf("%w: %s and %s share %s", errUnsupported, o, output, m)
+			}
+			owner[m] = output
+		}
+		counters = append(counters, &counter{
+			output:   output,
+			modules:  cone,
+			detector: cycle.NewDetector[string](),
+		})
+	}
+	return feed, counters, nil
+}
+
+// followCounters pushes the button until the state of every counter loops,
+// or until rx receives a low pulse, in which case it returns the press that
+// sent it. It checks that every high pulse from a counter is undone by a low
+// pulse in the same press, so that the conjunction feeding rx only sees the
+// counters high together in presses where all of them send high. When
+// pressed is set, it is called after every press.
+func followCounters(ctx context.Context, sim *circuit.Sim, feed string, counters []*counter, pressed func()) (int, error) {
+	outputs := make(map[string]*counter, len(counters))
+	for _, k := range counters {
+		k.detector.Add(sim.Key(k.modules))
+		outputs[k.output] = k
+		sim.Break(circuit.Breakpoint{Module: k.output, High: true})
+	}
+	sim.Break(circuit.Breakpoint{Module: feed, High: false})
+	defer sim.ClearBreaks()
+
+	for looping := 0; looping < len(counters); {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		press := sim.Presses() + 1
+		for p, hit := sim.Press(); hit; p, hit = sim.Run() {
+			if p.Source == feed {
+				return press, nil
+			}
+			k := outputs[p.Source]
+			if !k.found && p.Destination == feed && (len(k.high) == 0 || k.high[len(k.high)-1] != press) {
+				k.high = append(k.high, press)
+			}
+		}
+		if pressed != nil {
+			pressed()
+		}
+
+		memory := sim.Module(feed).Memory
+		for i, source := range sim.Circuit().Sources(feed) {
+			if memory[i] {
+				return 0, fmt.Errorf("%w: %s stays high after press %d", errUnsupported, source, press)
+			}
+		}
+		for _, k := range counters {
+			if k.found {
+				continue
+			}
+			if k.loop, k.found = k.detector.Add(sim.Key(k.modules)); k.found {
+				looping++
+			}
+		}
+	}
+	return 0, nil
+}
+
+// residue returns the press modulo the loop's period at which k sends a high
+// pulse, once it is in the loop.
+func (k *counter) residue() (int, error) {
+	result := -1
+	for _, press := range k.high {
+		if press <= k.loop.Start || press > k.loop.Len() {
+			continue
+		}
+		if result >= 0 {
+			return 0, fmt.Errorf("%w: %s sends several high pulses per loop", errUnsupported, k.output)
+		}
+		result = press % k.loop.Period
+	}
+	if result < 0 {
+		return 0, errNoLowPulse
+	}
+	return result, nil
+}
+
+// lineUp returns the first press in which every counter, once in its loop,
+// sends a high pulse. With loops starting at the first press and the high
+// pulse at their end, this is the least common multiple of the periods; in
+// general it is a solution of the Chinese remainder theorem.
+func lineUp(counters []*counter) (int, error) {
+	residues, moduli := make([]int, 0, len(counters)), make([]int, 0, len(counters))
+	start := 0
+	for _, k := range counters {
+		r, err := k.residue()
+		if err != nil {
+			return 0, err
+		}
+		residues, moduli = append(residues, r), append(moduli, k.loop.Period)
+		start = max(start, k.loop.Start)
+	}
+	press, period, err := mathx.CRT(residues, moduli)
+	if errors.Is(err, mathx.ErrNoSolution) {
+		return 0, errNoLowPulse
+	}
+	if err != nil {
+		return 0, err
+	}
+	// every counter has to be in its loop
+	for press <= start {
+		press += period
+	}
+	return press, nil
+}
@@ -0,0 +1,102 @@
+package day20
+
+import (
+	"context"
+	"strings"
+
+	"adventofcode23/internal/circuit"
+	"adventofcode23/internal/day"
+)
+
+type Day20 struct {
+	day.DayInput
+}
+
+func NewDay20(inputFile string) Day20 {
+	return Day20{day.NewInput(inputFile)}
+}
+
+func parseModule(src string) (string, circuit.Kind) {
+	switch src[0] {
+	case '%':
+		return src[1:], circuit.FlipFlop
+	case '&':
+		return src[1:], circuit.Conjunction
+	default:
+		return src, circuit.Broadcaster
+	}
+}
+
+func parseLines(lines []string) (*circuit.Circuit, error) {
+	modules := make([]circuit.Module, 0, len(lines))
+	seen := make(map[string]bool, len(lines))
+	for i, line := range lines {
+		src, dest, ok := strings.Cut(line, " -> ")
+		if !ok {
+			return nil, day.Locate(day.Errorf(1, "missing \" -> \""), i+1)
+		}
+		name, kind := parseModule(src)
+		if name == "" {
+			return nil, day.Locate(day.Errorf(1, "missing module name"), i+1)
+		}
+		if seen[name] {
+			return nil, day.Locate(day.Errorf(1, "duplicate module %s", name), i+1)
+		}
+		seen[name] = true
+		modules = append(modules, circuit.Module{Name: name, Kind: kind, Destinations: strings.Split(dest, ", ")})
+	}
+
+	result, err := circuit.New(modules)
+	if err != nil {
+		return nil, day.Locate(err, 0)
+	}
+	return result, nil
+}
+
+// presses is the number of button presses of part 1.
+const presses = 1000
+
+func (d Day20) Part1(ctx context.Context) (day.Answer, error) {
+	c, err := day.Parse(ctx, d.DayInput, parseLines)
+	if err != nil {
+		return day.Answer{}, err
+	}
+
+	sim := circuit.NewSim(c)
+	for i := 0; i < presses; i++ {
+		sim.Press()
+	}
+	low, high := sim.Counts()
+	return day.Int(low * high), nil
+}
+
+func (d Day20) Part2(ctx context.Context) (day.Answer, error) {
+	c, err := day.Parse(ctx, d.DayInput, parseLines)
+	if err != nil {
+		return day.Answer{}, err
+	}
+	feed, counters, err := decompose(c)
+	if err != nil {
+		return day.Answer{}, err
+	}
+
+	press, err := followCounters(ctx, circuit.NewSim(c), feed, counters, nil)
+	if err != nil {
+		return day.Answer{}, err
+	}
+	if press > 0 {
+		return day.Int(press), nil
+	}
+	press, err = lineUp(counters)
+	if err != nil {
+		return day.Answer{}, err
+	}
+	return day.Int(press), nil
+}
+
+func init() {
+	day.Register(day.Solver{
+		Day: 20,
+		New: func(inputFile string) day.Day { return NewDay20(inputFile) },
+	})
+}
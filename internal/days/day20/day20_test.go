@@ -0,0 +1,48 @@
+package day20
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"adventofcode23/internal/day/daytest"
+)
+
+func TestExamples(t *testing.T) {
+	t.Parallel()
+	daytest.RunExamples(t)
+}
+
+func BenchmarkParts(b *testing.B) {
+	daytest.BenchmarkParts(b)
+}
+
+func TestPart2Unsupported(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		lines []string
+		want  error
+	}{
+		{"no rx", []string{"broadcaster -> a", "%a -> b"}, errNoRx},
+		{"two sources", []string{"broadcaster -> a, b", "%a -> rx", "%b -> rx"}, errUnsupported},
+		{"flip-flop feed", []string{"broadcaster -> a", "%a -> f", "%f -> rx"}, errUnsupported},
+		{"shared state", []string{"broadcaster -> a", "%a -> x, y", "&x -> f", "&y -> f", "&f -> rx"}, errUnsupported},
+		{"feedback", []string{"broadcaster -> a", "%a -> x", "&x -> f", "&f -> rx, a"}, errUnsupported},
+	}
+
+	for _, test := range tests {
+		file := filepath.Join(t.TempDir(), "input.txt")
+		if err := os.WriteFile(file, []byte(strings.Join(test.lines, "\n")+"\n"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		_, err := NewDay20(file).Part2(context.Background())
+		if !errors.Is(err, test.want) {
+			t.Errorf("%s: want %v, got %v", test.name, test.want, err)
+		}
+	}
+}
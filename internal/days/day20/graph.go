@@ -0,0 +1,80 @@
+package day20
+
+import (
+	"context"
+
+	"adventofcode23/internal/circuit"
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/dot"
+)
+
+var _ day.Grapher = Day20{}
+
+// symbols are the prefixes of the input by kind.
+var symbols = map[circuit.Kind]string{
+	circuit.FlipFlop:    "%",
+	circuit.Conjunction: "&",
+}
+
+var shapes = map[circuit.Kind]string{
+	circuit.Output:      "plaintext",
+	circuit.FlipFlop:    "box",
+	circuit.Conjunction: "invtrapezium",
+	circuit.Broadcaster: "doubleoctagon",
+}
+
+func moduleAttrs(c *circuit.Circuit, name string) dot.Attrs {
+	k := c.Kind(name)
+	return dot.Attrs{"label": symbols[k] + name, "shape": shapes[k], "tooltip": k.String()}
+}
+
+// Dot returns the wiring of the modules. In part 2, the counters feeding rx
+// are drawn as clusters, and the pulses to rx highlighted.
+func (d Day20) Dot(ctx context.Context, part int) (*dot.Graph, error) {
+	c, err := day.Parse(ctx, d.DayInput, parseLines)
+	if err != nil {
+		return nil, err
+	}
+	g := dot.New("day20", true)
+	g.Set("rankdir", "LR")
+
+	var feed string
+	cluster := make(map[string]*dot.Graph)
+	if part == 2 {
+		var counters []*counter
+		feed, counters, err = decompose(c)
+		if err != nil {
+			return nil, err
+		}
+		for _, k := range counters {
+			sub := g.Cluster("counter " + k.output)
+			for _, m := range k.modules {
+				if c.Kind(m).Stateful() {
+					cluster[m] = sub
+				}
+			}
+		}
+	}
+
+	g.Node(circuit.Button, dot.Start.With(dot.Attrs{"shape": "circle"}))
+	g.Edge(circuit.Button, circuit.Broadcast, nil)
+	for _, name := range c.Names() {
+		attrs := moduleAttrs(c, name)
+		if name == rx && part == 2 {
+			attrs = attrs.With(dot.End)
+		}
+		if sub, ok := cluster[name]; ok {
+			sub.Node(name, attrs)
+		} else {
+			g.Node(name, attrs)
+		}
+		for _, dest := range c.Destinations(name) {
+			var attrs dot.Attrs
+			if part == 2 && (dest == feed || name == feed) {
+				attrs = dot.Highlight
+			}
+			g.Edge(name, dest, attrs)
+		}
+	}
+	return g, nil
+}
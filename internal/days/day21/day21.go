@@ -0,0 +1,133 @@
+package day21
+
+import (
+	"context"
+	"errors"
+
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/grid"
+	"adventofcode23/internal/mathx"
+)
+
+type Day21 struct {
+	day.DayInput
+	stepsPart1, stepsPart2 int
+}
+
+func NewDay21(inputFile string, stepsPart1, stepsPart2 int) Day21 {
+	return Day21{day.NewInput(inputFile), stepsPart1, stepsPart2}
+}
+
+var errNoStart = errors.New("no starting position S")
+
+// garden is a single tile of the infinitely repeating map of garden plots.
+type garden struct {
+	plots grid.Grid[byte]
+	start grid.Point
+}
+
+func (g garden) isRock(p grid.Point) bool {
+	return g.plots.GetWrapped(p) == '#'
+}
+
+// walk explores the plots reachable from start. After as many steps as each
+// of the ascending cycles, it calls reached with the index of the cycle and
+// the plots that can be reached in exactly that many steps.
+func (g garden) walk(start grid.Point, cycles []int, reached func(i int, plots map[grid.Point]struct{})) {
+	seen := [2]map[grid.Point]struct{}{{}, {}} // seen plots per parity
+	startStep := 0
+	todo := map[grid.Point]struct{}{start: {}}
+
+	for i := 0; i < len(cycles); i++ {
+		var parity int
+
+		for step := startStep; step <= cycles[i]; step++ {
+			parity = step % 2
+			todoNextStep := make(map[grid.Point]struct{})
+
+			for p := range todo {
+				if _, ok := seen[parity][p]; ok {
+					continue
+				}
+
+				seen[parity][p] = struct{}{}
+
+				for _, q := range p.Neighbours4() {
+					if g.isRock(q) {
+						continue
+					}
+					todoNextStep[q] = struct{}{}
+				}
+			}
+
+			todo = todoNextStep
+		}
+
+		reached(i, seen[parity])
+		startStep = cycles[i] + 1
+	}
+}
+
+func (g garden) countReachable(start grid.Point, cycles []int) []int {
+	result := make([]int, len(cycles))
+	g.walk(start, cycles, func(i int, plots map[grid.Point]struct{}) {
+		result[i] = len(plots)
+	})
+	return result
+}
+
+func makeGarden(lines []string) (garden, error) {
+	plots := grid.Bytes(lines)
+	start, found := plots.Find(func(b byte) bool { return b == 'S' })
+	if !found {
+		return garden{}, errNoStart
+	}
+	return garden{plots, start}, nil
+}
+
+func (d Day21) Part1(ctx context.Context) (day.Answer, error) {
+	lines, err := d.ReadGrid(ctx, ".#S")
+	if err != nil {
+		return day.Answer{}, err
+	}
+	garden, err := makeGarden(lines)
+	if err != nil {
+		return day.Answer{}, err
+	}
+
+	return day.Int(garden.countReachable(garden.start, []int{d.stepsPart1})[0]), nil
+}
+
+func (d Day21) Part2(ctx context.Context) (day.Answer, error) {
+	lines, err := d.ReadGrid(ctx, ".#S")
+	if err != nil {
+		return day.Answer{}, err
+	}
+	garden, err := makeGarden(lines)
+	if err != nil {
+		return day.Answer{}, err
+	}
+
+	nCycles := 3
+	cycles := make([]int, nCycles)
+	for i := 0; i < nCycles; i++ {
+		cycles[i] = d.stepsPart2%garden.plots.Rows() + i*garden.plots.Rows()
+	}
+
+	iterations := garden.countReachable(garden.start, cycles)
+
+	// the number of reachable plots grows quadratically with the number of
+	// times the garden is crossed
+	reachable, err := mathx.InterpolateInt([]int{0, 1, 2}, iterations, d.stepsPart2/garden.plots.Rows())
+	if err != nil {
+		return day.Answer{}, err
+	}
+	return day.Int(reachable), nil
+}
+
+func init() {
+	day.Register(day.Solver{
+		Day: 21,
+		New: func(inputFile string) day.Day { return NewDay21(inputFile, 64, 26501365) },
+	})
+}
@@ -0,0 +1,29 @@
+package day21
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/day/daytest"
+	"adventofcode23/internal/projectpath"
+)
+
+func TestExamplePart1(t *testing.T) {
+	t.Parallel()
+	d := NewDay21(filepath.Join(projectpath.Root, "cmd", "day21", "example.txt"), 6, 0)
+
+	want := day.Int(16)
+	got, err := d.Part1(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !want.Equal(got) {
+		t.Errorf("want %v, got %v", want, got)
+	}
+}
+
+func BenchmarkParts(b *testing.B) {
+	daytest.BenchmarkParts(b)
+}
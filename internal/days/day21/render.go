@@ -0,0 +1,46 @@
+package day21
+
+import (
+	"context"
+
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/grid"
+	"adventofcode23/internal/render"
+)
+
+var _ day.Renderer = Day21{}
+
+// Render draws the plots reachable in part 1. The infinite garden of part 2
+// has nothing to show.
+func (d Day21) Render(ctx context.Context, part int) (*render.Picture, error) {
+	if part == 2 {
+		return nil, nil
+	}
+	lines, err := d.ReadGrid(ctx, ".#S")
+	if err != nil {
+		return nil, err
+	}
+	garden, err := makeGarden(lines)
+	if err != nil {
+		return nil, err
+	}
+
+	result := render.New(garden.plots.Rows(), garden.plots.Columns())
+	for r := 0; r < garden.plots.Rows(); r++ {
+		result.SetText(r, 0, garden.plots.Row(r))
+		for c, ch := range garden.plots.Row(r) {
+			if ch == '#' {
+				result.Paint(r, c, render.Wall)
+			}
+		}
+	}
+	garden.walk(garden.start, []int{d.stepsPart1}, func(_ int, plots map[grid.Point]struct{}) {
+		for p := range plots {
+			if result.In(p.Row, p.Column) {
+				result.Set(p.Row, p.Column, render.Cell{Glyph: 'O', Color: render.Highlight})
+			}
+		}
+	})
+	result.Set(garden.start.Row, garden.start.Column, render.Cell{Glyph: 'S', Color: render.Start})
+	return result, nil
+}
@@ -0,0 +1,128 @@
+package day22
+
+import (
+	"context"
+
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/render"
+)
+
+var _ day.Animator = Day22{}
+
+// view shows the bricks from the front, x against z, and from the side, y
+// against z, next to each other, each cell showing the brick nearest to the
+// viewer. The ground is the bottom row.
+type view struct {
+	picture        *render.Picture
+	xs, ys, height int
+	front, side    []int // depth of the brick drawn in each cell
+}
+
+// gap separates the front from the side view.
+const gap = 3
+
+func newView(bricks []brick) *view {
+	xs, ys := maxAxis(bricks, x)+1, maxAxis(bricks, y)+1
+	height := maxAxis(bricks, z) + 1
+	return &view{
+		picture: render.New(height, xs+gap+ys),
+		xs:      xs,
+		ys:      ys,
+		height:  height,
+		front:   make([]int, height*xs),
+		side:    make([]int, height*ys),
+	}
+}
+
+// draw redraws the bricks, colored by their index.
+func (v *view) draw(bricks []brick, color func(i int) render.Color) {
+	for r := 0; r < v.height; r++ {
+		for c := 0; c < v.picture.Columns(); c++ {
+			v.picture.Set(r, c, render.Cell{Glyph: ' '})
+		}
+	}
+	for c := 0; c < v.xs; c++ {
+		v.picture.Set(v.height-1, c, render.Cell{Glyph: '-', Color: render.Wall})
+	}
+	for c := 0; c < v.ys; c++ {
+		v.picture.Set(v.height-1, v.xs+gap+c, render.Cell{Glyph: '-', Color: render.Wall})
+	}
+	for i := range v.front {
+		v.front[i] = -1
+	}
+	for i := range v.side {
+		v.side[i] = -1
+	}
+
+	for i, b := range bricks {
+		cell := render.Cell{Glyph: byte('A' + i%26), Color: color(i)}
+		for _, o := range b.occupies {
+			row := v.height - 1 - o[z]
+			if f := &v.front[row*v.xs+o[x]]; *f < 0 || o[y] < *f {
+				*f = o[y]
+				v.picture.Set(row, o[x], cell)
+			}
+			if s := &v.side[row*v.ys+o[y]]; *s < 0 || o[x] < *s {
+				*s = o[x]
+				v.picture.Set(row, v.xs+gap+o[y], cell)
+			}
+		}
+	}
+}
+
+// Animate shows the bricks landing one by one for part 1, and for part 2
+// which bricks fall when each brick is disintegrated in turn.
+func (d Day22) Animate(ctx context.Context, part int, record func(*render.Picture)) error {
+	if part == 1 {
+		bricks, err := d.bricks(ctx)
+		if err != nil {
+			return err
+		}
+		v := newView(bricks)
+		v.draw(bricks, func(int) render.Color { return render.Plain })
+		record(v.picture)
+
+		compact(bricks, func(landed int) {
+			v.draw(bricks, func(i int) render.Color {
+				switch {
+				case i == landed:
+					return render.Path
+				case i < landed:
+					return render.Fill
+				default:
+					return render.Plain
+				}
+			})
+			record(v.picture)
+		})
+		return nil
+	}
+
+	bricks, err := d.settled(ctx)
+	if err != nil {
+		return err
+	}
+	v := newView(bricks)
+	zb := makeZBuffer(maxAxis(bricks, x)+1, maxAxis(bricks, y)+1)
+	for removed := range bricks {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		falling := make(map[int]bool)
+		zb.countFalling(bricks, removed, func(i int) {
+			falling[i] = true
+		})
+		v.draw(bricks, func(i int) render.Color {
+			switch {
+			case i == removed:
+				return render.Path
+			case falling[i]:
+				return render.Highlight
+			default:
+				return render.Fill
+			}
+		})
+		record(v.picture)
+	}
+	return nil
+}
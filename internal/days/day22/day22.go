@@ -0,0 +1,312 @@
+package day22
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/parallel"
+)
+
+type Day22 struct {
+	day.DayInput
+}
+
+func NewDay22(inputFile string) Day22 {
+	return Day22{day.NewInput(inputFile)}
+}
+
+type axis int
+
+const (
+	x axis = iota
+	y
+	z
+)
+
+type coord map[axis]int
+
+type brick struct {
+	vertical bool
+	occupies []coord
+}
+
+type zBuffer [][]int
+
+func parseCoord(s string, column int) (coord, error) {
+	c, err := day.SplitInts(s, ",", column)
+	if err != nil {
+		return nil, err
+	}
+	if len(c) != 3 {
+		return nil, day.Errorf(column, "want x,y,z coordinates, got %q", s)
+	}
+	if min(c[0], c[1], c[2]) < 0 {
+		return nil, day.Errorf(column, "negative coordinate in %q", s)
+	}
+	return coord{x: c[0], y: c[1], z: c[2]}, nil
+}
+
+func newBrick(start, end coord) brick {
+	o := orientation(start, end)
+	vertical := o == z
+	if start[o] > end[o] {
+		start, end = end, start
+	}
+	length := end[o] - start[o] + 1
+	occupies := make([]coord, length)
+
+	for i := 0; i < length; i++ {
+		occupies[i] = make(coord)
+		for ax := range start {
+			occupies[i][ax] = start[ax]
+			if ax == o {
+				occupies[i][ax] += i
+			}
+		}
+	}
+
+	return brick{vertical, occupies}
+}
+
+func parseBrick(line string) (brick, error) {
+	// all bricks seem to be 1x1xh
+	s, e, ok := strings.Cut(line, "~")
+	if !ok {
+		return brick{}, day.Errorf(1, "missing \"~\" between brick ends")
+	}
+	start, err := parseCoord(s, 1)
+	if err != nil {
+		return brick{}, err
+	}
+	end, err := parseCoord(e, len(s)+2)
+	if err != nil {
+		return brick{}, err
+	}
+	differ := 0
+	for ax := range start {
+		if start[ax] != end[ax] {
+			differ++
+		}
+	}
+	if differ > 1 {
+		return brick{}, day.Errorf(1, "brick %q is not a straight line", line)
+	}
+	return newBrick(start, end), nil
+}
+
+func orientation(a, b coord) axis {
+	for ax := range a {
+		if a[ax] != b[ax] {
+			return ax
+		}
+	}
+	return x
+}
+
+func (b brick) start() coord {
+	return b.occupies[0]
+}
+
+func (b brick) end() coord {
+	return b.occupies[len(b.occupies)-1]
+}
+
+func (b brick) top() []coord {
+	if b.vertical {
+		return []coord{b.end()}
+	}
+
+	return b.occupies
+}
+
+func (b brick) lower(to int) {
+	for i := range b.occupies {
+		b.occupies[i][z] = to
+		if b.vertical {
+			b.occupies[i][z] += i
+		}
+	}
+}
+
+func (b brick) clone() brick {
+	occ := make([]coord, len(b.occupies))
+	for i, o := range b.occupies {
+		occ[i] = coord{x: o[x], y: o[y], z: o[z]}
+	}
+	return brick{b.vertical, occ}
+}
+
+func (zb *zBuffer) maxZ(b brick) int {
+	result := 0
+	for _, c := range b.occupies {
+		result = max(result, (*zb)[c[x]][c[y]])
+	}
+	return result
+}
+
+// compact lets the bricks, ordered by height, fall. When landed is set, it is
+// called with the index of every brick once it has come to rest.
+func compact(bricks []brick, landed func(i int)) {
+	zb := makeZBuffer(maxAxis(bricks, x)+1, maxAxis(bricks, y)+1)
+	for i, b := range bricks {
+		lowerableTo := zb.maxZ(b) + 1
+		bricks[i].lower(lowerableTo)
+		for _, c := range bricks[i].top() {
+			zb[c[x]][c[y]] = c[z]
+		}
+		if landed != nil {
+			landed(i)
+		}
+	}
+}
+
+func makeZBuffer(xMax, yMax int) zBuffer {
+	result := make(zBuffer, xMax)
+	for i := range result {
+		result[i] = make([]int, yMax)
+	}
+	return result
+}
+
+func (zb zBuffer) clone() zBuffer {
+	result := make(zBuffer, len(zb))
+	for i, zbx := range zb {
+		result[i] = make([]int, len(zbx))
+		copy(result[i], zb[i])
+	}
+	return result
+}
+
+// countFalling counts the bricks that fall when the brick at index layer is
+// disintegrated, given that zb holds the tops of the bricks below it. When
+// fell is set, it is called with the index of every falling brick.
+func (zb *zBuffer) countFalling(bricks []brick, layer int, fell func(i int)) int {
+	result := 0
+	localZb := zb.clone()
+
+	for i := layer + 1; i < len(bricks); i++ {
+		b := bricks[i]
+		lowerableTo := localZb.maxZ(b) + 1
+		if lowerableTo < b.start()[z] {
+			b = bricks[i].clone()
+			b.lower(lowerableTo)
+			result++
+			if fell != nil {
+				fell(i)
+			}
+		}
+
+		for _, c := range b.top() {
+			localZb[c[x]][c[y]] = c[z]
+		}
+	}
+
+	for _, c := range bricks[layer].top() {
+		(*zb)[c[x]][c[y]] = c[z]
+	}
+
+	return result
+}
+
+func maxAxis(bricks []brick, a axis) int {
+	result := 0
+	for _, b := range bricks {
+		result = max(result, b.end()[a])
+	}
+	return result
+}
+
+// fallingPerBrick returns for every brick the number of bricks that fall
+// when it is disintegrated. Each count starts from its own copy of the tops
+// of the bricks below, so that the bricks can be counted in parallel.
+func fallingPerBrick(ctx context.Context, bricks []brick) ([]int, error) {
+	below := make([]zBuffer, len(bricks))
+	zb := makeZBuffer(maxAxis(bricks, x)+1, maxAxis(bricks, y)+1)
+	for i, b := range bricks {
+		below[i] = zb.clone()
+		for _, c := range b.top() {
+			zb[c[x]][c[y]] = c[z]
+		}
+	}
+
+	layers := make([]int, len(bricks))
+	for i := range layers {
+		layers[i] = i
+	}
+	return parallel.Map(ctx, layers, func(layer int) int {
+		return below[layer].countFalling(bricks, layer, nil)
+	})
+}
+
+// bricks returns the bricks in the air, ordered by height.
+func (d Day22) bricks(ctx context.Context) ([]brick, error) {
+	bricks, err := day.ParseLines(ctx, d.DayInput, parseBrick)
+	if err != nil {
+		return nil, err
+	}
+
+	// sort on z
+	sort.Slice(bricks, func(i, j int) bool {
+		return bricks[i].start()[z] < bricks[j].start()[z]
+	})
+	return bricks, nil
+}
+
+// settled returns the bricks after they have fallen, ordered by height. They
+// are settled once for both parts.
+func (d Day22) settled(ctx context.Context) ([]brick, error) {
+	return day.Once(d.DayInput, "settled", func() ([]brick, error) {
+		bricks, err := d.bricks(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		compact(bricks, nil)
+		return bricks, nil
+	})
+}
+
+func (d Day22) Part1(ctx context.Context) (day.Answer, error) {
+	bricks, err := d.settled(ctx)
+	if err != nil {
+		return day.Answer{}, err
+	}
+	falling, err := fallingPerBrick(ctx, bricks)
+	if err != nil {
+		return day.Answer{}, err
+	}
+
+	disintegratable := 0
+	for _, n := range falling {
+		if n == 0 {
+			disintegratable++
+		}
+	}
+	return day.Int(disintegratable), nil
+}
+
+func (d Day22) Part2(ctx context.Context) (day.Answer, error) {
+	bricks, err := d.settled(ctx)
+	if err != nil {
+		return day.Answer{}, err
+	}
+	falling, err := fallingPerBrick(ctx, bricks)
+	if err != nil {
+		return day.Answer{}, err
+	}
+
+	sum := 0
+	for _, n := range falling {
+		sum += n
+	}
+	return day.Int(sum), nil
+}
+
+func init() {
+	day.Register(day.Solver{
+		Day: 22,
+		New: func(inputFile string) day.Day { return NewDay22(inputFile) },
+	})
+}
@@ -0,0 +1,60 @@
+package day22
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/day/daytest"
+)
+
+func TestExamples(t *testing.T) {
+	t.Parallel()
+	daytest.RunExamples(t)
+}
+
+func TestParseBrickError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		line   string
+		column int
+	}{
+		{"1,0,1 1,2,1", 1},
+		{"1,0,1~1,x,1", 9},
+		{"1,0,1~1,2", 7},
+		{"1,0,1~1,2,2", 1},
+	}
+
+	for _, test := range tests {
+		_, err := parseBrick(test.line)
+		var pe *day.ParseError
+		if !errors.As(err, &pe) {
+			t.Fatalf("%q: want *day.ParseError, got %v", test.line, err)
+		}
+		if pe.Column != test.column {
+			t.Errorf("%q: want column %d, got %d", test.line, test.column, pe.Column)
+		}
+	}
+}
+
+func BenchmarkParts(b *testing.B) {
+	daytest.BenchmarkParts(b)
+}
+
+func BenchmarkCountFalling(b *testing.B) {
+	bricks, err := NewDay22(daytest.Input(b, 2)).settled(context.Background())
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		zb := makeZBuffer(maxAxis(bricks, x)+1, maxAxis(bricks, y)+1)
+		for layer := range bricks {
+			zb.countFalling(bricks, layer, nil)
+		}
+	}
+}
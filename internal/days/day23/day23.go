@@ -0,0 +1,187 @@
+package day23
+
+import (
+	"context"
+
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/graph"
+	"adventofcode23/internal/grid"
+)
+
+type Day23 struct {
+	day.DayInput
+}
+
+// tile is a position on the hiking map.
+type tile = grid.Point
+
+type area struct {
+	neighbours    map[tile][]tile
+	intersections []tile // includes start, end
+	start, end    tile
+}
+
+type edge struct {
+	to       tile
+	distance int
+}
+
+// trails connects the intersections by the length of the trail between them.
+type trails struct {
+	*graph.Graph[tile]
+	start, end tile
+}
+
+var slopes = map[byte]grid.Direction{
+	'^': grid.North,
+	'>': grid.East,
+	'v': grid.South,
+	'<': grid.West,
+}
+
+func NewDay23(inputFile string) Day23 {
+	return Day23{day.NewInput(inputFile)}
+}
+
+func parseTiles(input []string) grid.Grid[byte] {
+	// surround the map by forest so that every tile has four neighbours
+	return grid.Bytes(input).Pad(1, '#')
+}
+
+// slipperyMoves are the moves from a tile of part 1, where slopes can only be
+// walked down.
+func slipperyMoves(ch byte) []grid.Direction {
+	switch ch {
+	case '^', '>', 'v', '<':
+		return []grid.Direction{slopes[ch]}
+	case '.':
+		return grid.Directions
+	default:
+		return nil
+	}
+}
+
+// dryMoves are the moves from a tile of part 2, where slopes are paths.
+func dryMoves(ch byte) []grid.Direction {
+	switch ch {
+	case '.', '^', '>', 'v', '<':
+		return grid.Directions
+	default:
+		return nil
+	}
+}
+
+func makeArea(tiles grid.Grid[byte], moves func(byte) []grid.Direction) area {
+	neighbours := make(map[tile][]tile)
+	intersections := make([]tile, 0)
+	var start, end tile
+
+	for r := 0; r < tiles.Rows(); r++ {
+		for c, ch := range tiles.Row(r) {
+			t := tile{Row: r, Column: c}
+			neighbours[t] = make([]tile, 0)
+			for _, m := range moves(ch) {
+				n := t.Move(m)
+				if tiles.Get(n) == '#' {
+					continue
+				}
+				neighbours[t] = append(neighbours[t], n)
+			}
+			if len(neighbours[t]) > 2 {
+				intersections = append(intersections, t)
+			}
+			if r == 1 && ch == '.' {
+				start = t
+			} else if r == tiles.Rows()-2 && ch == '.' {
+				end = t
+			}
+		}
+	}
+
+	intersections = append(append(intersections, start), end)
+	return area{neighbours, intersections, start, end}
+}
+
+func (a area) neighbourIntersections(e edge, exclude int, seen map[tile]struct{}) []edge {
+	for i := range a.intersections {
+		if i == exclude {
+			continue
+		}
+		if a.intersections[i] == e.to {
+			return []edge{e}
+		}
+	}
+
+	seen[e.to] = struct{}{}
+	result := make([]edge, 0)
+	for _, n := range a.neighbours[e.to] {
+		if _, ok := seen[n]; ok {
+			continue
+		}
+		edges := a.neighbourIntersections(edge{n, e.distance + 1}, exclude, seen)
+		result = append(result, edges...)
+	}
+	return result
+}
+
+func (a area) makeTrails() trails {
+	g := graph.NewDirected[tile]()
+
+	for i, intersection := range a.intersections {
+		g.AddNode(intersection)
+		seen := make(map[tile]struct{})
+		for _, e := range a.neighbourIntersections(edge{intersection, 0}, i, seen) {
+			g.AddEdge(intersection, e.to, e.distance)
+		}
+	}
+	return trails{g, a.start, a.end}
+}
+
+func (t trails) maxDistance(ctx context.Context) (int, error) {
+	path, err := graph.LongestPath(ctx, t.Graph, t.start, t.end)
+	if err != nil {
+		return 0, err
+	}
+	return path.Cost, nil
+}
+
+func (d Day23) Part1(ctx context.Context) (day.Answer, error) {
+	lines, err := d.ReadGrid(ctx, ".#^>v<")
+	if err != nil {
+		return day.Answer{}, err
+	}
+	tiles := parseTiles(lines)
+	area := makeArea(tiles, slipperyMoves)
+
+	trails := area.makeTrails()
+
+	distance, err := trails.maxDistance(ctx)
+	if err != nil {
+		return day.Answer{}, err
+	}
+	return day.Int(distance), nil
+}
+
+func (d Day23) Part2(ctx context.Context) (day.Answer, error) {
+	lines, err := d.ReadGrid(ctx, ".#^>v<")
+	if err != nil {
+		return day.Answer{}, err
+	}
+	tiles := parseTiles(lines)
+	area := makeArea(tiles, dryMoves)
+
+	trails := area.makeTrails()
+
+	distance, err := trails.maxDistance(ctx)
+	if err != nil {
+		return day.Answer{}, err
+	}
+	return day.Int(distance), nil
+}
+
+func init() {
+	day.Register(day.Solver{
+		Day: 23,
+		New: func(inputFile string) day.Day { return NewDay23(inputFile) },
+	})
+}
@@ -0,0 +1,33 @@
+package day23
+
+import (
+	"context"
+	"testing"
+
+	"adventofcode23/internal/day/daytest"
+)
+
+func TestExamples(t *testing.T) {
+	t.Parallel()
+	daytest.RunExamples(t)
+}
+
+func BenchmarkParts(b *testing.B) {
+	daytest.BenchmarkParts(b)
+}
+
+func BenchmarkMaxDistance(b *testing.B) {
+	lines, err := NewDay23(daytest.Input(b, 2)).ReadGrid(context.Background(), ".#^>v<")
+	if err != nil {
+		b.Fatal(err)
+	}
+	trails := makeArea(parseTiles(lines), dryMoves).makeTrails()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := trails.maxDistance(context.Background()); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package day23
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/dot"
+	"adventofcode23/internal/graph"
+)
+
+var _ day.Grapher = Day23{}
+
+// junction names an intersection by its row and column in the input.
+func junction(t tile) string {
+	// the map is padded by one tile of forest
+	return fmt.Sprintf("%d,%d", t.Row-1, t.Column-1)
+}
+
+// Dot returns the intersections joined by the lengths of the trails between
+// them, with the longest hike highlighted. Trails can only be walked one way
+// in part 1, so its graph is directed.
+func (d Day23) Dot(ctx context.Context, part int) (*dot.Graph, error) {
+	lines, err := d.ReadGrid(ctx, ".#^>v<")
+	if err != nil {
+		return nil, err
+	}
+	moves := slipperyMoves
+	if part == 2 {
+		moves = dryMoves
+	}
+	trails := makeArea(parseTiles(lines), moves).makeTrails()
+	hike, err := graph.LongestPath(ctx, trails.Graph, trails.start, trails.end)
+	if err != nil {
+		return nil, err
+	}
+
+	type step struct{ from, to tile }
+	hiked := make(map[step]bool, len(hike.Nodes))
+	for i := 1; i < len(hike.Nodes); i++ {
+		hiked[step{hike.Nodes[i-1], hike.Nodes[i]}] = true
+		if part == 2 {
+			hiked[step{hike.Nodes[i], hike.Nodes[i-1]}] = true
+		}
+	}
+
+	g := dot.New("day23", part == 1)
+	g.Node(junction(trails.start), dot.Start)
+	g.Node(junction(trails.end), dot.End)
+	drawn := make(map[step]bool)
+	for _, e := range trails.Edges() {
+		if drawn[step{e.To, e.From}] {
+			// the same trail walked back in part 2
+			continue
+		}
+		drawn[step{e.From, e.To}] = true
+		attrs := dot.Attrs{"label": strconv.Itoa(e.Weight)}
+		if hiked[step{e.From, e.To}] {
+			attrs = attrs.With(dot.Highlight)
+		}
+		g.Edge(junction(e.From), junction(e.To), attrs)
+	}
+	return g, nil
+}
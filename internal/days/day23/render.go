@@ -0,0 +1,79 @@
+package day23
+
+import (
+	"context"
+
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/graph"
+	"adventofcode23/internal/render"
+)
+
+var _ day.Renderer = Day23{}
+
+// trail returns the tiles of the longest trail from intersection a to
+// intersection b, excluding a.
+func (a area) trail(from, to tile) []tile {
+	isIntersection := make(map[tile]bool, len(a.intersections))
+	for _, t := range a.intersections {
+		isIntersection[t] = true
+	}
+
+	var result []tile
+	for _, n := range a.neighbours[from] {
+		trail := []tile{n}
+		for previous, current := from, n; !isIntersection[current]; {
+			next, ok := previous, false
+			for _, m := range a.neighbours[current] {
+				if m != previous {
+					next, ok = m, true
+				}
+			}
+			if !ok {
+				// dead end
+				break
+			}
+			previous, current = current, next
+			trail = append(trail, current)
+		}
+		if trail[len(trail)-1] == to && len(trail) > len(result) {
+			result = trail
+		}
+	}
+	return result
+}
+
+// Render draws the longest hike.
+func (d Day23) Render(ctx context.Context, part int) (*render.Picture, error) {
+	lines, err := d.ReadGrid(ctx, ".#^>v<")
+	if err != nil {
+		return nil, err
+	}
+	moves := slipperyMoves
+	if part == 2 {
+		moves = dryMoves
+	}
+	area := makeArea(parseTiles(lines), moves)
+	trails := area.makeTrails()
+	hike, err := graph.LongestPath(ctx, trails.Graph, trails.start, trails.end)
+	if err != nil {
+		return nil, err
+	}
+
+	result := render.New(len(lines), len(lines[0]))
+	for r, line := range lines {
+		result.SetText(r, 0, []byte(line))
+		for c := range line {
+			if line[c] == '#' {
+				result.Paint(r, c, render.Wall)
+			}
+		}
+	}
+	// the tiles are padded with forest
+	for i := 1; i < len(hike.Nodes); i++ {
+		for _, t := range area.trail(hike.Nodes[i-1], hike.Nodes[i]) {
+			result.Set(t.Row-1, t.Column-1, render.Cell{Glyph: 'O', Color: render.Path})
+		}
+	}
+	result.Set(area.start.Row-1, area.start.Column-1, render.Cell{Glyph: 'S', Color: render.Start})
+	return result, nil
+}
@@ -0,0 +1,236 @@
+package day24
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/mathx"
+)
+
+type Day24 struct {
+	day.DayInput
+	lower, upper int
+}
+
+// vector is indexed by axis.
+type vector [3]int
+
+type hailstone struct {
+	position, velocity vector
+}
+
+const (
+	x = iota
+	y
+	z
+)
+
+var errNoRock = errors.New("no rock trajectory hits all hailstones")
+
+func NewDay24(inputFile string, lower, upper int) Day24 {
+	return Day24{day.NewInput(inputFile), lower, upper}
+}
+
+func parseVector(s string, column int) (vector, error) {
+	v, err := day.SplitInts(s, ",", column)
+	if err != nil {
+		return vector{}, err
+	}
+	if len(v) != 3 {
+		return vector{}, day.Errorf(column, "want x, y, z, got %q", s)
+	}
+	return vector{v[0], v[1], v[2]}, nil
+}
+
+func parseLine(line string) (hailstone, error) {
+	p, v, ok := strings.Cut(line, "@")
+	if !ok {
+		return hailstone{}, day.Errorf(1, "missing \"@\" between position and velocity")
+	}
+	position, err := parseVector(p, 1)
+	if err != nil {
+		return hailstone{}, err
+	}
+	velocity, err := parseVector(v, len(p)+2)
+	if err != nil {
+		return hailstone{}, err
+	}
+	return hailstone{position, velocity}, nil
+}
+
+func (h hailstone) String() string {
+	return fmt.Sprintf("%d, %d, %d @ %d, %d, %d", h.position[x], h.position[y], h.position[z], h.velocity[x], h.velocity[y], h.velocity[z])
+}
+
+func rat(n int) *big.Rat {
+	return new(big.Rat).SetInt64(int64(n))
+}
+
+// crossPathsXY reports whether the paths of a and b cross within the test
+// area in the future, ignoring the z axis. It solves
+// a.position + t·a.velocity = b.position + s·b.velocity for t and s.
+func crossPathsXY(a, b hailstone, lower, upper *big.Rat) bool {
+	m := mathx.IntMatrix(
+		[]int{a.velocity[x], -b.velocity[x]},
+		[]int{a.velocity[y], -b.velocity[y]},
+	)
+	v := mathx.IntVector(b.position[x]-a.position[x], b.position[y]-a.position[y])
+	ts, err := mathx.Solve(m, v)
+	if err != nil {
+		// parallel paths
+		return false
+	}
+	if ts[0].Sign() < 0 || ts[1].Sign() < 0 {
+		// crossed in the past
+		return false
+	}
+
+	for _, axis := range []int{x, y} {
+		c := new(big.Rat).Mul(ts[0], rat(a.velocity[axis]))
+		c.Add(c, rat(a.position[axis]))
+		if c.Cmp(lower) < 0 || c.Cmp(upper) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func countIntersections(hailstones []hailstone, lower, upper int) int {
+	lo, hi := rat(lower), rat(upper)
+	result := 0
+	for i := range hailstones {
+		for j := i + 1; j < len(hailstones); j++ {
+			if crossPathsXY(hailstones[i], hailstones[j], lo, hi) {
+				result++
+			}
+		}
+	}
+	return result
+}
+
+// crossMatrix returns the matrix of the cross product with a, so that
+// crossMatrix(a)·b = a × b.
+func crossMatrix(a vector) [3][3]int {
+	return [3][3]int{
+		{0, -a[z], a[y]},
+		{a[z], 0, -a[x]},
+		{-a[y], a[x], 0},
+	}
+}
+
+func cross(a, b vector) *[3]*big.Int {
+	c := crossMatrix(a)
+	var result [3]*big.Int
+	for i, row := range c {
+		result[i] = new(big.Int)
+		for j := range row {
+			t := big.NewInt(int64(row[j]))
+			result[i].Add(result[i], t.Mul(t, big.NewInt(int64(b[j]))))
+		}
+	}
+	return &result
+}
+
+func sub(a, b vector) vector {
+	return vector{a[x] - b[x], a[y] - b[y], a[z] - b[z]}
+}
+
+// rockEquations returns the three linear equations in the rock's position P
+// and velocity V that follow from hitting both a and b. The rock hits a
+// hailstone h iff (P - h.position) × (V - h.velocity) = 0; subtracting that
+// equation for a from the one for b cancels the non-linear term P × V:
+//
+//	P × (b.v - a.v) + (b.p - a.p) × V = b.p × b.v - a.p × a.v
+func rockEquations(a, b hailstone) ([][]*big.Rat, []*big.Rat) {
+	// P × w = -(w × P)
+	cp := crossMatrix(sub(b.velocity, a.velocity))
+	cv := crossMatrix(sub(b.position, a.position))
+	rhsB, rhsA := cross(b.position, b.velocity), cross(a.position, a.velocity)
+
+	m := make([][]*big.Rat, 3)
+	v := make([]*big.Rat, 3)
+	for i := 0; i < 3; i++ {
+		m[i] = make([]*big.Rat, 6)
+		for j := 0; j < 3; j++ {
+			m[i][j] = rat(-cp[i][j])
+			m[i][j+3] = rat(cv[i][j])
+		}
+		v[i] = new(big.Rat).SetInt(new(big.Int).Sub(rhsB[i], rhsA[i]))
+	}
+	return m, v
+}
+
+// findRock solves for the rock's position and velocity using the first three
+// hailstones whose equations are independent.
+func findRock(hailstones []hailstone) (hailstone, error) {
+	for i := 0; i+2 < len(hailstones); i++ {
+		a, b, c := hailstones[i], hailstones[i+1], hailstones[i+2]
+		m1, v1 := rockEquations(a, b)
+		m2, v2 := rockEquations(a, c)
+
+		solution, err := mathx.Solve(append(m1, m2...), append(v1, v2...))
+		if errors.Is(err, mathx.ErrSingular) {
+			continue
+		}
+		if err != nil {
+			return hailstone{}, err
+		}
+
+		var rock hailstone
+		for j, r := range solution {
+			n, err := mathx.RatInt(r)
+			if err != nil {
+				// the rock has to be thrown from whole coordinates
+				return hailstone{}, errNoRock
+			}
+			if j < 3 {
+				rock.position[j] = n
+			} else {
+				rock.velocity[j-3] = n
+			}
+		}
+		return rock, nil
+	}
+	return hailstone{}, errNoRock
+}
+
+// hailstones returns the hailstones, parsed once for both parts.
+func (d Day24) hailstones(ctx context.Context) ([]hailstone, error) {
+	return day.Once(d.DayInput, "hailstones", func() ([]hailstone, error) {
+		return day.ParseLines(ctx, d.DayInput, parseLine)
+	})
+}
+
+func (d Day24) Part1(ctx context.Context) (day.Answer, error) {
+	hailstones, err := d.hailstones(ctx)
+	if err != nil {
+		return day.Answer{}, err
+	}
+
+	return day.Int(countIntersections(hailstones, d.lower, d.upper)), nil
+}
+
+func (d Day24) Part2(ctx context.Context) (day.Answer, error) {
+	hailstones, err := d.hailstones(ctx)
+	if err != nil {
+		return day.Answer{}, err
+	}
+
+	rock, err := findRock(hailstones)
+	if err != nil {
+		return day.Answer{}, err
+	}
+
+	return day.Int(rock.position[x] + rock.position[y] + rock.position[z]), nil
+}
+
+func init() {
+	day.Register(day.Solver{
+		Day: 24,
+		New: func(inputFile string) day.Day { return NewDay24(inputFile, 2e14, 4e14) },
+	})
+}
@@ -0,0 +1,59 @@
+package day24
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/day/daytest"
+	"adventofcode23/internal/projectpath"
+)
+
+func TestExamplePart1(t *testing.T) {
+	t.Parallel()
+	d := NewDay24(filepath.Join(projectpath.Root, "cmd", "day24", "example.txt"), 7, 27)
+
+	want := day.Int(2)
+	got, err := d.Part1(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !want.Equal(got) {
+		t.Errorf("want %v, got %v", want, got)
+	}
+}
+
+func TestExamples(t *testing.T) {
+	t.Parallel()
+	daytest.RunExamples(t)
+}
+
+func TestParseLineError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		line   string
+		column int
+	}{
+		{"19, 13, 30 -2, 1, -2", 1},
+		{"19, 13, 30 @ -2, x, -2", 18},
+		{"19, 13 @ -2, 1, -2", 1},
+	}
+
+	for _, test := range tests {
+		_, err := parseLine(test.line)
+		var pe *day.ParseError
+		if !errors.As(err, &pe) {
+			t.Fatalf("%q: want *day.ParseError, got %v", test.line, err)
+		}
+		if pe.Column != test.column {
+			t.Errorf("%q: want column %d, got %d", test.line, test.column, pe.Column)
+		}
+	}
+}
+
+func BenchmarkParts(b *testing.B) {
+	daytest.BenchmarkParts(b)
+}
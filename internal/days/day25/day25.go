@@ -0,0 +1,75 @@
+package day25
+
+import (
+	"context"
+	"math/rand"
+	"strings"
+
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/graph"
+)
+
+type Day25 struct {
+	day.DayInput
+}
+
+// seed makes the random contractions, and so the run time, repeatable.
+const seed = 25
+
+func NewDay25(inputFile string) Day25 {
+	return Day25{day.NewInput(inputFile)}
+}
+
+func parseGraph(lines []string) (*graph.Graph[string], error) {
+	result := graph.NewUndirected[string]()
+	for i, line := range lines {
+		component, c, ok := strings.Cut(line, ": ")
+		if !ok || component == "" {
+			return nil, day.Locate(day.Errorf(1, "invalid component %q", line), i+1)
+		}
+		connections := strings.Fields(c)
+		if len(connections) == 0 {
+			return nil, day.Locate(day.Errorf(len(component)+3, "no connections"), i+1)
+		}
+		for _, connection := range connections {
+			result.AddEdge(component, connection, 1)
+		}
+	}
+	return result, nil
+}
+
+// findCut repeats Karger's algorithm until it finds a cut of 3 edges, or ctx
+// is done.
+func findCut(ctx context.Context, g *graph.Graph[string]) (graph.Cut[string], error) {
+	rng := rand.New(rand.NewSource(seed))
+	for ctx.Err() == nil {
+		if cut := graph.Karger(g, rng); cut.Weight == 3 {
+			return cut, nil
+		}
+	}
+	return graph.Cut[string]{}, ctx.Err()
+}
+
+func (d Day25) Part1(ctx context.Context) (day.Answer, error) {
+	g, err := day.Parse(ctx, d.DayInput, parseGraph)
+	if err != nil {
+		return day.Answer{}, err
+	}
+
+	cut, err := findCut(ctx, g)
+	if err != nil {
+		return day.Answer{}, err
+	}
+	return day.Int(len(cut.Sides[0]) * len(cut.Sides[1])), nil
+}
+
+func (d Day25) Part2(ctx context.Context) (day.Answer, error) {
+	return day.NotApplicable, nil
+}
+
+func init() {
+	day.Register(day.Solver{
+		Day: 25,
+		New: func(inputFile string) day.Day { return NewDay25(inputFile) },
+	})
+}
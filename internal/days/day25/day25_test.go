@@ -0,0 +1,79 @@
+package day25
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/day/daytest"
+)
+
+func TestExamples(t *testing.T) {
+	t.Parallel()
+	daytest.RunExamples(t)
+}
+
+func TestNoCutTimeout(t *testing.T) {
+	t.Parallel()
+	// a triangle has no cut of three wires, so the search never ends
+	input := filepath.Join(t.TempDir(), "input.txt")
+	if err := os.WriteFile(input, []byte("a: b c\nb: c\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := NewDay25(input).Part1(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("want %v, got %v", context.DeadlineExceeded, err)
+	}
+}
+
+func BenchmarkParts(b *testing.B) {
+	daytest.BenchmarkParts(b)
+}
+
+func BenchmarkFindCut(b *testing.B) {
+	g, err := day.Parse(context.Background(), NewDay25(daytest.Input(b, 1)).DayInput, parseGraph)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := findCut(context.Background(), g); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestDotHighlightsCut(t *testing.T) {
+	t.Parallel()
+	input := filepath.Join(daytest.Dir(25), "example.txt")
+
+	g, err := NewDay25(input).Dot(context.Background(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b strings.Builder
+	if _, err := g.WriteTo(&b); err != nil {
+		t.Fatal(err)
+	}
+	// the example is cut at hfx/pzl, bvb/cmg and nvd/jqt
+	if got := strings.Count(b.String(), `color="red"`); got != 3 {
+		t.Errorf("want 3 highlighted wires, got %d in\n%s", got, b.String())
+	}
+	if !strings.Contains(b.String(), `"hfx" -- "pzl" [color="red"`) && !strings.Contains(b.String(), `"pzl" -- "hfx" [color="red"`) {
+		t.Errorf("want hfx/pzl highlighted in\n%s", b.String())
+	}
+
+	if g, err := NewDay25(input).Dot(context.Background(), 2); err != nil || g != nil {
+		t.Errorf("part 2: want no graph, got %v, %v", g, err)
+	}
+}
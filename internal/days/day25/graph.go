@@ -0,0 +1,50 @@
+package day25
+
+import (
+	"context"
+
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/dot"
+	"adventofcode23/internal/graph"
+)
+
+var _ day.Grapher = Day25{}
+
+// sideColors fill the components of either side of the cut.
+var sideColors = [2]string{"lightblue", "lightyellow"}
+
+// Dot returns the wiring with the components coloured by side of the cut of
+// part 1, and the wires to disconnect highlighted. Part 2 has no graph.
+func (d Day25) Dot(ctx context.Context, part int) (*dot.Graph, error) {
+	if part == 2 {
+		return nil, nil
+	}
+	g, err := day.Parse(ctx, d.DayInput, parseGraph)
+	if err != nil {
+		return nil, err
+	}
+	cut, err := findCut(ctx, g)
+	if err != nil {
+		return nil, err
+	}
+
+	result := dot.New("day25", false)
+	for side, components := range cut.Sides {
+		for _, c := range components {
+			result.Node(c, dot.Attrs{"style": "filled", "fillcolor": sideColors[side]})
+		}
+	}
+	isCut := make(map[graph.Edge[string]]bool, 2*len(cut.Edges))
+	for _, e := range cut.Edges {
+		isCut[e] = true
+		isCut[graph.Edge[string]{From: e.To, To: e.From, Weight: e.Weight}] = true
+	}
+	for _, e := range g.Edges() {
+		var attrs dot.Attrs
+		if isCut[e] {
+			attrs = dot.Highlight
+		}
+		result.Edge(e.From, e.To, attrs)
+	}
+	return result, nil
+}
@@ -0,0 +1,61 @@
+package day25b
+
+import (
+	"context"
+	"strings"
+
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/graph"
+)
+
+type Day25b struct {
+	day.DayInput
+}
+
+func NewDay25b(inputFile string) Day25b {
+	return Day25b{day.NewInput(inputFile)}
+}
+
+func parseGraph(lines []string) (*graph.Graph[string], error) {
+	result := graph.NewUndirected[string]()
+	for i, line := range lines {
+		component, c, ok := strings.Cut(line, ": ")
+		if !ok || component == "" {
+			return nil, day.Locate(day.Errorf(1, "invalid component %q", line), i+1)
+		}
+		connections := strings.Fields(c)
+		if len(connections) == 0 {
+			return nil, day.Locate(day.Errorf(len(component)+3, "no connections"), i+1)
+		}
+		for _, connection := range connections {
+			result.AddEdge(component, connection, 1)
+		}
+	}
+	return result, nil
+}
+
+func (d Day25b) Part1(ctx context.Context) (day.Answer, error) {
+	g, err := day.Parse(ctx, d.DayInput, parseGraph)
+	if err != nil {
+		return day.Answer{}, err
+	}
+
+	cut, err := graph.StoerWagner(ctx, g)
+	if err != nil {
+		return day.Answer{}, err
+	}
+
+	return day.Int(len(cut.Sides[0]) * len(cut.Sides[1])), nil
+}
+
+func (d Day25b) Part2(ctx context.Context) (day.Answer, error) {
+	return day.NotApplicable, nil
+}
+
+func init() {
+	day.Register(day.Solver{
+		Day:     25,
+		Variant: "b",
+		New:     func(inputFile string) day.Day { return NewDay25b(inputFile) },
+	})
+}
@@ -0,0 +1,34 @@
+package day25b
+
+import (
+	"context"
+	"testing"
+
+	"adventofcode23/internal/day"
+	"adventofcode23/internal/day/daytest"
+	"adventofcode23/internal/graph"
+)
+
+func TestExamples(t *testing.T) {
+	t.Parallel()
+	daytest.RunExamples(t)
+}
+
+func BenchmarkParts(b *testing.B) {
+	daytest.BenchmarkParts(b)
+}
+
+func BenchmarkStoerWagner(b *testing.B) {
+	g, err := day.Parse(context.Background(), NewDay25b(daytest.Input(b, 1)).DayInput, parseGraph)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := graph.StoerWagner(context.Background(), g); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
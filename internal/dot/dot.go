@@ -0,0 +1,149 @@
+// Package dot writes graphs in the DOT language of Graphviz, so that they can
+// be drawn with its tools, as in 'dot -Tsvg day20-part1.dot'. The output
+// only depends on the order in which nodes and edges are added.
+package dot
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// Attrs are the attributes of a graph, node or edge, such as label, color or
+// shape.
+type Attrs map[string]string
+
+// With returns a copy of a with the attributes of b added.
+func (a Attrs) With(b Attrs) Attrs {
+	result := make(Attrs, len(a)+len(b))
+	for k, v := range a {
+		result[k] = v
+	}
+	for k, v := range b {
+		result[k] = v
+	}
+	return result
+}
+
+// Attributes shared by the days, so that their graphs look alike.
+var (
+	// Highlight marks the edges of a result, such as a path or a cut.
+	Highlight = Attrs{"color": "red", "penwidth": "2.5"}
+	// Start and End mark where a result starts and ends.
+	Start = Attrs{"style": "filled", "fillcolor": "palegreen"}
+	End   = Attrs{"style": "filled", "fillcolor": "lightpink"}
+)
+
+type node struct {
+	id    string
+	attrs Attrs
+}
+
+type edge struct {
+	from, to string
+	attrs    Attrs
+}
+
+// Graph is a directed or undirected graph with attributes, possibly with
+// clusters of nodes drawn together.
+type Graph struct {
+	id       string
+	directed bool
+	attrs    Attrs
+	nodes    []node
+	edges    []edge
+	clusters []*Graph
+}
+
+// New returns an empty graph called id.
+func New(id string, directed bool) *Graph {
+	return &Graph{id: id, directed: directed, attrs: make(Attrs)}
+}
+
+// Set sets an attribute of the graph itself, such as rankdir.
+func (g *Graph) Set(key, value string) {
+	g.attrs[key] = value
+}
+
+// Node adds a node. Nodes only named by edges need not be added.
+func (g *Graph) Node(id string, attrs Attrs) {
+	g.nodes = append(g.nodes, node{id, attrs})
+}
+
+// Edge adds an edge.
+func (g *Graph) Edge(from, to string, attrs Attrs) {
+	g.edges = append(g.edges, edge{from, to, attrs})
+}
+
+// Cluster adds a cluster with the given label. Nodes added to the cluster
+// are drawn inside a box.
+func (g *Graph) Cluster(label string) *Graph {
+	c := New(fmt.Sprintf("cluster_%d", len(g.clusters)), g.directed)
+	c.Set("label", label)
+	g.clusters = append(g.clusters, c)
+	return c
+}
+
+// Quote returns s as a DOT string.
+func Quote(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+	return `"` + r.Replace(s) + `"`
+}
+
+func formatAttrs(attrs Attrs) string {
+	if len(attrs) == 0 {
+		return ""
+	}
+	keys := make([]string, 0, len(attrs))
+	for k := range attrs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, len(keys))
+	for i, k := range keys {
+		parts[i] = k + "=" + Quote(attrs[k])
+	}
+	return " [" + strings.Join(parts, ", ") + "]"
+}
+
+func (g *Graph) write(b *strings.Builder, keyword, indent string) {
+	fmt.Fprintf(b, "%s%s %s {\n", indent, keyword, Quote(g.id))
+	inner := indent + "\t"
+	keys := make([]string, 0, len(g.attrs))
+	for k := range g.attrs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fmt.Fprintf(b, "%s%s=%s;\n", inner, k, Quote(g.attrs[k]))
+	}
+
+	for _, c := range g.clusters {
+		c.write(b, "subgraph", inner)
+	}
+	for _, n := range g.nodes {
+		fmt.Fprintf(b, "%s%s%s;\n", inner, Quote(n.id), formatAttrs(n.attrs))
+	}
+	arrow := " -- "
+	if g.directed {
+		arrow = " -> "
+	}
+	for _, e := range g.edges {
+		fmt.Fprintf(b, "%s%s%s%s%s;\n", inner, Quote(e.from), arrow, Quote(e.to), formatAttrs(e.attrs))
+	}
+	fmt.Fprintf(b, "%s}\n", indent)
+}
+
+// WriteTo writes g in the DOT language.
+func (g *Graph) WriteTo(w io.Writer) (int64, error) {
+	keyword := "graph"
+	if g.directed {
+		keyword = "digraph"
+	}
+	var b strings.Builder
+	g.write(&b, keyword, "")
+	n, err := io.WriteString(w, b.String())
+	return int64(n), err
+}
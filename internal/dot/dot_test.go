@@ -0,0 +1,60 @@
+package dot
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestWriteTo(t *testing.T) {
+	t.Parallel()
+
+	g := New("day20", true)
+	g.Set("rankdir", "LR")
+	c := g.Cluster("counter a")
+	c.Node("a", Attrs{"shape": "box", "label": "%a"})
+	g.Node(`say "hi"`, nil)
+	g.Edge("a", "b", Highlight.With(Attrs{"label": "x<5\nelse"}))
+
+	want := `digraph "day20" {
+	rankdir="LR";
+	subgraph "cluster_0" {
+		label="counter a";
+		"a" [label="%a", shape="box"];
+	}
+	"say \"hi\"";
+	"a" -> "b" [color="red", label="x<5\nelse", penwidth="2.5"];
+}
+`
+	var b strings.Builder
+	if _, err := g.WriteTo(&b); err != nil {
+		t.Fatal(err)
+	}
+	if got := b.String(); got != want {
+		t.Errorf("want\n%s\ngot\n%s", want, got)
+	}
+}
+
+func TestUndirected(t *testing.T) {
+	t.Parallel()
+
+	g := New("day25", false)
+	g.Edge("a", "b", nil)
+
+	want := "graph \"day25\" {\n\t\"a\" -- \"b\";\n}\n"
+	var b strings.Builder
+	if _, err := g.WriteTo(&b); err != nil {
+		t.Fatal(err)
+	}
+	if got := b.String(); got != want {
+		t.Errorf("want %q, got %q", want, got)
+	}
+}
+
+func TestWithCopies(t *testing.T) {
+	t.Parallel()
+
+	a := Highlight.With(Attrs{"color": "blue"})
+	if a["color"] != "blue" || Highlight["color"] != "red" {
+		t.Errorf("want a changed copy, got %v and %v", a, Highlight)
+	}
+}
@@ -0,0 +1,65 @@
+package gen
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"strings"
+)
+
+var almanacMaps = []string{
+	"seed-to-soil",
+	"soil-to-fertilizer",
+	"fertilizer-to-water",
+	"water-to-light",
+	"light-to-temperature",
+	"temperature-to-humidity",
+	"humidity-to-location",
+}
+
+// almanacSpan bounds the numbers of an almanac, like those of the puzzle.
+const almanacSpan = 1 << 32
+
+// Almanac generates an almanac for day 5 with size seed ranges and size
+// ranges in every map. Every map is a permutation of the numbers below 2^32:
+// it cuts them into size blocks and lays the blocks out in random order. The
+// seed ranges are up to 100 times size long, so that trying every seed stays
+// feasible.
+func Almanac(r *rand.Rand, size int) Puzzle {
+	size = max(size, 1)
+	seeds := make([]string, 0, 2*size)
+	for i := 0; i < size; i++ {
+		length := between(r, 1, 100*size)
+		seeds = append(seeds, fmt.Sprint(r.Intn(almanacSpan-length)), fmt.Sprint(length))
+	}
+	lines := []string{"seeds: " + strings.Join(seeds, " ")}
+
+	for _, name := range almanacMaps {
+		lines = append(lines, "", name+" map:")
+		lines = append(lines, almanacMap(r, size)...)
+	}
+	return Puzzle{Lines: lines}
+}
+
+func almanacMap(r *rand.Rand, size int) []string {
+	cuts := []int{0, almanacSpan}
+	for len(cuts) < size+1 {
+		cuts = append(cuts, between(r, 1, almanacSpan-1))
+		slices.Sort(cuts)
+		cuts = slices.Compact(cuts)
+	}
+
+	order := r.Perm(size)
+	destination := make([]int, size)
+	next := 0
+	for _, block := range order {
+		destination[block] = next
+		next += cuts[block+1] - cuts[block]
+	}
+
+	result := make([]string, size)
+	for i, block := range r.Perm(size) {
+		result[i] = fmt.Sprintf("%d %d %d", destination[block], cuts[block], cuts[block+1]-cuts[block])
+	}
+	return result
+}
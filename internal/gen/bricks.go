@@ -0,0 +1,46 @@
+package gen
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// bricksFootprint is the width and depth of the space the bricks fall in.
+const bricksFootprint = 10
+
+// Bricks generates a snapshot of size falling bricks for day 22. Bricks are
+// straight lines of up to 5 cubes along a random axis, above the ground at
+// z = 0 and not overlapping.
+func Bricks(r *rand.Rand, size int) Puzzle {
+	size = max(size, 1)
+	height := max(10, size/5)
+	occupied := make(map[[3]int]bool)
+	lines := make([]string, 0, size)
+	for len(lines) < size {
+		start := [3]int{r.Intn(bricksFootprint), r.Intn(bricksFootprint), between(r, 1, height)}
+		end := start
+		axis := r.Intn(3)
+		end[axis] += r.Intn(5)
+		if end[0] >= bricksFootprint || end[1] >= bricksFootprint {
+			continue
+		}
+
+		cubes := make([][3]int, 0, 5)
+		for c := start; c[axis] <= end[axis]; c[axis]++ {
+			cubes = append(cubes, c)
+		}
+		free := true
+		for _, c := range cubes {
+			free = free && !occupied[c]
+		}
+		if !free {
+			continue
+		}
+		for _, c := range cubes {
+			occupied[c] = true
+		}
+
+		lines = append(lines, fmt.Sprintf("%d,%d,%d~%d,%d,%d", start[0], start[1], start[2], end[0], end[1], end[2]))
+	}
+	return Puzzle{Lines: lines}
+}
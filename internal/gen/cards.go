@@ -0,0 +1,64 @@
+package gen
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"adventofcode23/internal/day"
+)
+
+const (
+	winningNumbers = 10
+	cardNumbers    = 25
+)
+
+// Cards generates size scratchcards for day 4, each with 10 winning numbers
+// and 25 numbers of its own. Like in real inputs, no card wins copies of
+// cards past the end of the table.
+func Cards(r *rand.Rand, size int) Puzzle {
+	size = max(size, 1)
+	width := len(fmt.Sprint(size))
+	lines := make([]string, size)
+	points := 0
+	copies := make([]int, size)
+	for i := range copies {
+		copies[i] = 1
+	}
+
+	for i := range lines {
+		matches := min(r.Intn(winningNumbers+1), size-1-i)
+		numbers := r.Perm(99)[:winningNumbers+cardNumbers-matches]
+		winning := numbers[:winningNumbers]
+		own := append(append([]int(nil), winning[:matches]...), numbers[winningNumbers:]...)
+		r.Shuffle(len(own), func(a, b int) { own[a], own[b] = own[b], own[a] })
+
+		lines[i] = fmt.Sprintf("Card %*d: %s | %s", width, i+1, format(winning), format(own))
+
+		if matches > 0 {
+			points += 1 << (matches - 1)
+		}
+		for j := i + 1; j <= i+matches; j++ {
+			copies[j] += copies[i]
+		}
+	}
+
+	total := 0
+	for _, c := range copies {
+		total += c
+	}
+	return Puzzle{lines, map[int]day.Answer{1: day.Int(points), 2: day.Int(total)}}
+}
+
+// format writes numbers from 0 to 98, shifted to 1 to 99, right aligned in
+// columns of two like the puzzle.
+func format(numbers []int) string {
+	var b strings.Builder
+	for i, n := range numbers {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		fmt.Fprintf(&b, "%2d", n+1)
+	}
+	return b.String()
+}
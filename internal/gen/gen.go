@@ -0,0 +1,91 @@
+// Package gen generates random puzzle inputs in the format of a day, for
+// fuzzing the solvers and for measuring them at scale. Generation is
+// deterministic: the same seed and size always give the same input.
+package gen
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"sort"
+
+	"adventofcode23/internal/day"
+)
+
+// ErrNoGenerator is returned for days without a generator.
+var ErrNoGenerator = errors.New("no generator")
+
+// Puzzle is a generated input along with the answers its construction
+// guarantees, keyed by part. Parts whose answer is not known are missing.
+type Puzzle struct {
+	Lines   []string
+	Answers map[int]day.Answer
+}
+
+// WriteTo writes the lines of p to w.
+func (p Puzzle) WriteTo(w io.Writer) (int64, error) {
+	var n int64
+	for _, line := range p.Lines {
+		m, err := fmt.Fprintln(w, line)
+		n += int64(m)
+		if err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+// Generator produces puzzles for a day. What the size counts depends on the
+// day; Size is the size of a real puzzle input.
+type Generator struct {
+	Size     int
+	Generate func(r *rand.Rand, size int) Puzzle
+}
+
+var generators = map[int]Generator{
+	4:  {Size: 200, Generate: Cards},
+	5:  {Size: 10, Generate: Almanac},
+	10: {Size: 140, Generate: Pipes},
+	12: {Size: 1000, Generate: Springs},
+	22: {Size: 1200, Generate: Bricks},
+	24: {Size: 300, Generate: Hailstones},
+	25: {Size: 1500, Generate: Wiring},
+}
+
+// Lookup returns the generator for day n.
+func Lookup(n int) (Generator, bool) {
+	g, ok := generators[n]
+	return g, ok
+}
+
+// Days returns the days with a generator, in order.
+func Days() []int {
+	result := make([]int, 0, len(generators))
+	for n := range generators {
+		result = append(result, n)
+	}
+	sort.Ints(result)
+	return result
+}
+
+// Generate returns the puzzle for day n with the given seed and size. A size
+// of 0 selects the size of a real input.
+func Generate(n int, seed int64, size int) (Puzzle, error) {
+	g, ok := Lookup(n)
+	if !ok {
+		return Puzzle{}, fmt.Errorf("day %d: %w", n, ErrNoGenerator)
+	}
+	if size < 0 {
+		return Puzzle{}, fmt.Errorf("invalid size %d", size)
+	}
+	if size == 0 {
+		size = g.Size
+	}
+	return g.Generate(rand.New(rand.NewSource(seed)), size), nil
+}
+
+// between returns a random number in [lo, hi].
+func between(r *rand.Rand, lo, hi int) int {
+	return lo + r.Intn(hi-lo+1)
+}
@@ -0,0 +1,100 @@
+package gen
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"adventofcode23/internal/day"
+	_ "adventofcode23/internal/days/day04"
+	_ "adventofcode23/internal/days/day05"
+	_ "adventofcode23/internal/days/day05b"
+	_ "adventofcode23/internal/days/day10"
+	_ "adventofcode23/internal/days/day12"
+	_ "adventofcode23/internal/days/day22"
+	_ "adventofcode23/internal/days/day24"
+	_ "adventofcode23/internal/days/day25"
+	_ "adventofcode23/internal/days/day25b"
+)
+
+// checkSolvable solves the puzzle of day n with seed and size with every
+// implementation of the day and compares the answers known by construction.
+func checkSolvable(t *testing.T, n int, seed int64, size int) {
+	t.Helper()
+	p, err := Generate(n, seed, size)
+	if err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Join(t.TempDir(), "input.txt")
+	f, err := os.Create(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.WriteTo(f); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	for _, s := range day.Variants(n) {
+		got, err := day.SolveAll(ctx, s.New(file))
+		if err != nil {
+			t.Fatalf("%s, seed %d, size %d: %v", s.Name(), seed, size, err)
+		}
+		for part, want := range p.Answers {
+			if g := []day.Answer{got.Part1, got.Part2}[part-1]; !want.Equal(g) {
+				t.Errorf("%s, seed %d, size %d: part %d: want %v, got %v", s.Name(), seed, size, part, want, g)
+			}
+		}
+	}
+}
+
+func TestSolvable(t *testing.T) {
+	t.Parallel()
+	for _, n := range Days() {
+		for seed := int64(1); seed <= 5; seed++ {
+			checkSolvable(t, n, seed, 30)
+		}
+	}
+}
+
+func TestDeterministic(t *testing.T) {
+	t.Parallel()
+	for _, n := range Days() {
+		a, _ := Generate(n, 42, 50)
+		b, _ := Generate(n, 42, 50)
+		c, _ := Generate(n, 43, 50)
+		if !slices.Equal(a.Lines, b.Lines) {
+			t.Errorf("day %d: same seed, different puzzles", n)
+		}
+		if slices.Equal(a.Lines, c.Lines) {
+			t.Errorf("day %d: different seeds, same puzzle", n)
+		}
+	}
+}
+
+func TestNoGenerator(t *testing.T) {
+	t.Parallel()
+	if _, err := Generate(1, 1, 0); !errors.Is(err, ErrNoGenerator) {
+		t.Errorf("want %v, got %v", ErrNoGenerator, err)
+	}
+}
+
+func FuzzGenerators(f *testing.F) {
+	f.Add(int64(1), uint8(10))
+	f.Add(int64(-7), uint8(0))
+	f.Fuzz(func(t *testing.T, seed int64, size uint8) {
+		// small sizes keep the quadratic solvers fast; 0 would select the
+		// size of a real input
+		for _, n := range Days() {
+			checkSolvable(t, n, seed, int(size%64)+1)
+		}
+	})
+}
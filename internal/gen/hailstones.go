@@ -0,0 +1,40 @@
+package gen
+
+import (
+	"fmt"
+	"math/rand"
+
+	"adventofcode23/internal/day"
+)
+
+// Hailstones generates size hailstones for day 24, at least three, placed
+// so that a rock thrown from a random position with a random velocity hits
+// every one of them, each at its own time. Positions are of the magnitude of
+// the puzzle's.
+func Hailstones(r *rand.Rand, size int) Puzzle {
+	size = max(size, 3)
+	var position, velocity [3]int
+	for i := range position {
+		position[i] = between(r, 1e14, 4e14)
+		velocity[i] = between(r, -300, 300)
+	}
+
+	times := make(map[int]bool, size)
+	lines := make([]string, 0, size)
+	for len(lines) < size {
+		t := between(r, 1e11, 1e12)
+		var p, v [3]int
+		for i := range v {
+			v[i] = between(r, -300, 300)
+		}
+		if times[t] || v == velocity {
+			continue
+		}
+		times[t] = true
+		for i := range p {
+			p[i] = position[i] + (velocity[i]-v[i])*t
+		}
+		lines = append(lines, fmt.Sprintf("%d, %d, %d @ %d, %d, %d", p[0], p[1], p[2], v[0], v[1], v[2]))
+	}
+	return Puzzle{lines, map[int]day.Answer{2: day.Int(position[0] + position[1] + position[2])}}
+}
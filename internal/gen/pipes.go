@@ -0,0 +1,159 @@
+package gen
+
+import (
+	"math/rand"
+
+	"adventofcode23/internal/day"
+)
+
+// region is a set of unit squares between the tiles of a pipe diagram. Its
+// outline runs through the tiles.
+type region struct {
+	size  int
+	cells [][]bool
+}
+
+func (g region) in(i, j int) bool {
+	return i >= 0 && j >= 0 && i < g.size && j < g.size && g.cells[i][j]
+}
+
+// touchesDiagonally reports whether the squares around corner (i, j) meet
+// only at that corner, where the outline would cross itself.
+func (g region) touchesDiagonally(i, j int) bool {
+	a, b := g.in(i-1, j-1), g.in(i-1, j)
+	c, d := g.in(i, j-1), g.in(i, j)
+	return a == d && b == c && a != b
+}
+
+// grow adds random squares next to the region, keeping its outline a simple
+// loop, until it has target squares or cannot grow.
+func (g region) grow(r *rand.Rand, start [2]int, target int) int {
+	frontier := [][2]int{start}
+	count := 0
+	for count < target && len(frontier) > 0 {
+		k := r.Intn(len(frontier))
+		c := frontier[k]
+		frontier[k] = frontier[len(frontier)-1]
+		frontier = frontier[:len(frontier)-1]
+		i, j := c[0], c[1]
+		if i < 0 || j < 0 || i >= g.size || j >= g.size || g.cells[i][j] {
+			continue
+		}
+
+		g.cells[i][j] = true
+		if g.touchesDiagonally(i, j) || g.touchesDiagonally(i, j+1) ||
+			g.touchesDiagonally(i+1, j) || g.touchesDiagonally(i+1, j+1) {
+			g.cells[i][j] = false
+			continue
+		}
+		count++
+		frontier = append(frontier, [2]int{i - 1, j}, [2]int{i + 1, j}, [2]int{i, j - 1}, [2]int{i, j + 1})
+	}
+	return count
+}
+
+// fillHoles adds the squares not connected to the border, so that the
+// outline is a single loop. It returns the number of squares added.
+func (g region) fillHoles() int {
+	outside := make([][]bool, g.size)
+	for i := range outside {
+		outside[i] = make([]bool, g.size)
+	}
+	var stack [][2]int
+	for k := 0; k < g.size; k++ {
+		stack = append(stack, [2]int{0, k}, [2]int{g.size - 1, k}, [2]int{k, 0}, [2]int{k, g.size - 1})
+	}
+	for len(stack) > 0 {
+		c := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		i, j := c[0], c[1]
+		if i < 0 || j < 0 || i >= g.size || j >= g.size || g.cells[i][j] || outside[i][j] {
+			continue
+		}
+		outside[i][j] = true
+		stack = append(stack, [2]int{i - 1, j}, [2]int{i + 1, j}, [2]int{i, j - 1}, [2]int{i, j + 1})
+	}
+
+	added := 0
+	for i := range outside {
+		for j := range outside[i] {
+			if !outside[i][j] && !g.cells[i][j] {
+				g.cells[i][j] = true
+				added++
+			}
+		}
+	}
+	return added
+}
+
+var junk = []byte("|-LJ7F.")
+
+// Pipes generates a size by size pipe diagram for day 10. The main loop is
+// the outline of a random region without holes; the tiles off the loop are
+// random pipes, except next to S, whose connections must be unambiguous.
+func Pipes(r *rand.Rand, size int) Puzzle {
+	size = max(size, 3)
+	g := region{size - 1, make([][]bool, size-1)}
+	for i := range g.cells {
+		g.cells[i] = make([]bool, size-1)
+	}
+	area := g.grow(r, [2]int{r.Intn(g.size), r.Intn(g.size)}, g.size*g.size*2/5)
+	area += g.fillHoles()
+
+	// the outline runs along the edges between tiles, east and south of a
+	// tile where the squares on either side differ
+	east := func(row, column int) bool { return g.in(row-1, column) != g.in(row, column) }
+	south := func(row, column int) bool { return g.in(row, column-1) != g.in(row, column) }
+
+	tiles := make([][]byte, size)
+	var loop [][2]int
+	for row := range tiles {
+		tiles[row] = make([]byte, size)
+		for column := range tiles[row] {
+			n, s := row > 0 && south(row-1, column), south(row, column)
+			w, e := column > 0 && east(row, column-1), east(row, column)
+			switch {
+			case n && s:
+				tiles[row][column] = '|'
+			case e && w:
+				tiles[row][column] = '-'
+			case n && e:
+				tiles[row][column] = 'L'
+			case n && w:
+				tiles[row][column] = 'J'
+			case s && w:
+				tiles[row][column] = '7'
+			case s && e:
+				tiles[row][column] = 'F'
+			default:
+				tiles[row][column] = junk[r.Intn(len(junk))]
+				continue
+			}
+			loop = append(loop, [2]int{row, column})
+		}
+	}
+
+	start := loop[r.Intn(len(loop))]
+	tiles[start[0]][start[1]] = 'S'
+	onLoop := make(map[[2]int]bool, len(loop))
+	for _, t := range loop {
+		onLoop[t] = true
+	}
+	for _, d := range [][2]int{{-1, 0}, {1, 0}, {0, -1}, {0, 1}} {
+		row, column := start[0]+d[0], start[1]+d[1]
+		if row >= 0 && column >= 0 && row < size && column < size && !onLoop[[2]int{row, column}] {
+			tiles[row][column] = '.'
+		}
+	}
+
+	lines := make([]string, size)
+	for row, t := range tiles {
+		lines[row] = string(t)
+	}
+	// by Pick's theorem, the area of the loop is the number of tiles inside
+	// plus half the tiles on it minus one
+	return Puzzle{lines, map[int]day.Answer{
+		1: day.Int(len(loop) / 2),
+		2: day.Int(area - len(loop)/2 + 1),
+	}}
+}
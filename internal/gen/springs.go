@@ -0,0 +1,36 @@
+package gen
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+)
+
+// Springs generates size condition records for day 12. Each record is a
+// random row of up to 20 springs with a random part of them unknown, so it
+// has at least one arrangement: the row it was made from.
+func Springs(r *rand.Rand, size int) Puzzle {
+	size = max(size, 1)
+	lines := make([]string, size)
+	for i := range lines {
+		springs := make([]byte, between(r, 1, 20))
+		for k := range springs {
+			springs[k] = ".#"[r.Intn(2)]
+		}
+		springs[r.Intn(len(springs))] = '#'
+
+		groups := make([]string, 0)
+		for _, group := range strings.FieldsFunc(string(springs), func(c rune) bool { return c == '.' }) {
+			groups = append(groups, fmt.Sprint(len(group)))
+		}
+
+		unknown := r.Float64()
+		for k := range springs {
+			if r.Float64() < unknown {
+				springs[k] = '?'
+			}
+		}
+		lines[i] = string(springs) + " " + strings.Join(groups, ",")
+	}
+	return Puzzle{Lines: lines}
+}
@@ -0,0 +1,70 @@
+package gen
+
+import (
+	"math/rand"
+	"strings"
+
+	"adventofcode23/internal/day"
+)
+
+// maxComponents is the number of distinct three letter component names.
+const maxComponents = 26 * 26 * 26
+
+// Wiring generates a wiring diagram of size components for day 25, between
+// 10 and 17576. It wires two groups of components so that neither can be
+// split by cutting fewer than four wires, and connects the groups with
+// three wires: the only cut of three.
+func Wiring(r *rand.Rand, size int) Puzzle {
+	size = min(max(size, 10), maxComponents)
+	names := make([]string, size)
+	for i, n := range r.Perm(maxComponents)[:size] {
+		names[i] = string([]byte{'a' + byte(n/676), 'a' + byte(n/26%26), 'a' + byte(n%26)})
+	}
+
+	// edges are kept in the order they are made, so that the wiring only
+	// depends on r
+	var edges [][2]int
+	seen := make(map[[2]int]bool)
+	connect := func(a, b int) {
+		e := [2]int{min(a, b), max(a, b)}
+		if a != b && !seen[e] {
+			seen[e] = true
+			edges = append(edges, e)
+		}
+	}
+	// a ring where every component is also wired to the one after next has
+	// no cut of fewer than four wires
+	group := func(from, to int) {
+		n := to - from
+		for i := 0; i < n; i++ {
+			connect(from+i, from+(i+1)%n)
+			connect(from+i, from+(i+2)%n)
+			connect(from+i, from+r.Intn(n))
+		}
+	}
+	split := between(r, 5, size-5)
+	group(0, split)
+	group(split, size)
+	for cut := 0; cut < 3; {
+		a, b := r.Intn(split), between(r, split, size-1)
+		if !seen[[2]int{a, b}] {
+			connect(a, b)
+			cut++
+		}
+	}
+
+	wires := make(map[int][]string)
+	for _, e := range edges {
+		if r.Intn(2) == 0 {
+			e[0], e[1] = e[1], e[0]
+		}
+		wires[e[0]] = append(wires[e[0]], names[e[1]])
+	}
+	lines := make([]string, 0, len(wires))
+	for _, i := range r.Perm(size) {
+		if w, ok := wires[i]; ok {
+			lines = append(lines, names[i]+": "+strings.Join(w, " "))
+		}
+	}
+	return Puzzle{lines, map[int]day.Answer{1: day.Int(split * (size - split)), 2: day.NotApplicable}}
+}
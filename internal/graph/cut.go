@@ -0,0 +1,194 @@
+package graph
+
+import (
+	"context"
+	"math"
+	"math/rand"
+)
+
+// Cut splits the nodes of a graph into two sides. Edges are the edges with one
+// end on either side, Weight the sum of their weights.
+type Cut[N comparable] struct {
+	Sides  [2][]N
+	Edges  []Edge[N]
+	Weight int
+}
+
+// cut builds the Cut of g in which the nodes with inFirst set form the first
+// side.
+func (g *Graph[N]) cut(inFirst []bool) Cut[N] {
+	var result Cut[N]
+	for id, n := range g.nodes {
+		if inFirst[id] {
+			result.Sides[0] = append(result.Sides[0], n)
+		} else {
+			result.Sides[1] = append(result.Sides[1], n)
+		}
+	}
+	for f, arcs := range g.arcs {
+		for _, a := range arcs {
+			if !g.directed && a.to < f || inFirst[f] == inFirst[a.to] {
+				continue
+			}
+			result.Edges = append(result.Edges, Edge[N]{g.nodes[f], g.nodes[a.to], a.weight})
+			result.Weight += a.weight
+		}
+	}
+	return result
+}
+
+// subsets is a union-find structure over node ids.
+type subsets struct {
+	parent, rank []int
+}
+
+func newSubsets(n int) subsets {
+	result := subsets{make([]int, n), make([]int, n)}
+	for i := range result.parent {
+		result.parent[i] = i
+	}
+	return result
+}
+
+func (s subsets) find(x int) int {
+	for s.parent[x] != x {
+		s.parent[x] = s.parent[s.parent[x]]
+		x = s.parent[x]
+	}
+	return x
+}
+
+// union joins the subsets of x and y and reports whether they were apart.
+func (s subsets) union(x, y int) bool {
+	x, y = s.find(x), s.find(y)
+	switch {
+	case x == y:
+		return false
+	case s.rank[x] < s.rank[y]:
+		s.parent[x] = y
+	case s.rank[x] > s.rank[y]:
+		s.parent[y] = x
+	default:
+		s.parent[y] = x
+		s.rank[x]++
+	}
+	return true
+}
+
+// Karger runs one round of Karger's randomised contraction on g, ignoring the
+// direction of edges: edges picked uniformly at random are contracted until
+// two groups of nodes remain. The result is a minimum cut with a probability
+// of at least 2/n², so callers repeat it until the cut is small enough. Edge
+// weights only count towards the weight of the cut.
+func Karger[N comparable](g *Graph[N], rng *rand.Rand) Cut[N] {
+	edges := make([][2]int, 0)
+	for f, arcs := range g.arcs {
+		for _, a := range arcs {
+			if g.directed || a.to > f {
+				edges = append(edges, [2]int{f, a.to})
+			}
+		}
+	}
+	rng.Shuffle(len(edges), func(i, j int) {
+		edges[i], edges[j] = edges[j], edges[i]
+	})
+
+	s := newSubsets(len(g.nodes))
+	groups := len(g.nodes)
+	for _, e := range edges {
+		if groups <= 2 {
+			break
+		}
+		if s.union(e[0], e[1]) {
+			groups--
+		}
+	}
+
+	inFirst := make([]bool, len(g.nodes))
+	if len(g.nodes) > 0 {
+		first := s.find(0)
+		for id := range inFirst {
+			inFirst[id] = s.find(id) == first
+		}
+	}
+	return g.cut(inFirst)
+}
+
+// StoerWagner returns a minimum cut of a connected graph, ignoring the
+// direction of edges but taking their weights into account. It takes O(n³)
+// time for n nodes.
+func StoerWagner[N comparable](ctx context.Context, g *Graph[N]) (Cut[N], error) {
+	// adapted from https://en.wikipedia.org/wiki/Stoer%E2%80%93Wagner_algorithm
+	n := len(g.nodes)
+	weights := make([][]int, n)
+	for i := range weights {
+		weights[i] = make([]int, n)
+	}
+	for f, arcs := range g.arcs {
+		for _, a := range arcs {
+			if a.to == f || !g.directed && a.to < f {
+				continue
+			}
+			// directions are ignored, so each edge counts both ways
+			weights[f][a.to] += a.weight
+			weights[a.to][f] += a.weight
+		}
+	}
+
+	merged := make([][]int, n) // nodes contracted into each node
+	for i := range merged {
+		merged[i] = []int{i}
+	}
+	active := make([]bool, n)
+	for i := range active {
+		active[i] = true
+	}
+
+	bestWeight := math.MaxInt
+	var best []int
+	for phase := 1; phase < n; phase++ {
+		if err := ctx.Err(); err != nil {
+			return Cut[N]{}, err
+		}
+
+		// grow a set A by the most tightly connected node until all
+		// active nodes are added; s and t are the last two
+		w := make([]int, n)
+		added := make([]bool, n)
+		s, t := -1, -1
+		for it := 0; it <= n-phase; it++ {
+			next := -1
+			for v := 0; v < n; v++ {
+				if active[v] && !added[v] && (next == -1 || w[v] > w[next]) {
+					next = v
+				}
+			}
+			added[next] = true
+			s, t = t, next
+			for v := 0; v < n; v++ {
+				if !added[v] {
+					w[v] += weights[next][v]
+				}
+			}
+		}
+
+		// w[t] is the weight of the cut separating t from the rest
+		if w[t] < bestWeight {
+			bestWeight = w[t]
+			best = append([]int(nil), merged[t]...)
+		}
+
+		merged[s] = append(merged[s], merged[t]...)
+		for v := 0; v < n; v++ {
+			weights[s][v] += weights[t][v]
+			weights[v][s] = weights[s][v]
+		}
+		active[t] = false
+	}
+
+	inFirst := make([]bool, n)
+	for _, id := range best {
+		inFirst[id] = true
+	}
+	return g.cut(inFirst), nil
+}
@@ -0,0 +1,150 @@
+// Package graph provides a weighted graph whose nodes are identified by
+// values of any comparable type, along with the search and cut algorithms
+// the puzzles need.
+package graph
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrNoPath is returned when no path connects the requested nodes.
+var ErrNoPath = errors.New("graph: no path")
+
+// Edge is a weighted edge between two nodes.
+type Edge[N comparable] struct {
+	From, To N
+	Weight   int
+}
+
+// Path is a sequence of nodes joined by edges, with the sum of their weights.
+type Path[N comparable] struct {
+	Nodes []N
+	Cost  int
+}
+
+type arc struct {
+	to, weight int
+}
+
+// Graph is a directed or undirected graph with non-negative integer edge
+// weights. Nodes are stored densely in the order they are added.
+type Graph[N comparable] struct {
+	directed  bool
+	nodes     []N
+	ids       map[N]int
+	arcs      [][]arc
+	maxWeight int
+}
+
+// NewDirected returns an empty directed graph.
+func NewDirected[N comparable]() *Graph[N] {
+	return &Graph[N]{directed: true, ids: make(map[N]int)}
+}
+
+// NewUndirected returns an empty undirected graph.
+func NewUndirected[N comparable]() *Graph[N] {
+	return &Graph[N]{ids: make(map[N]int)}
+}
+
+// Directed reports whether edges only lead from their From to their To node.
+func (g *Graph[N]) Directed() bool {
+	return g.directed
+}
+
+// AddNode adds n to the graph, unless it is already there.
+func (g *Graph[N]) AddNode(n N) {
+	g.id(n)
+}
+
+func (g *Graph[N]) id(n N) int {
+	if id, ok := g.ids[n]; ok {
+		return id
+	}
+	id := len(g.nodes)
+	g.ids[n] = id
+	g.nodes = append(g.nodes, n)
+	g.arcs = append(g.arcs, nil)
+	return id
+}
+
+// AddEdge adds an edge between from and to, adding the nodes as needed. In an
+// undirected graph the edge can be followed both ways. Parallel edges are
+// kept. AddEdge panics on a negative weight.
+func (g *Graph[N]) AddEdge(from, to N, weight int) {
+	if weight < 0 {
+		panic(fmt.Sprintf("graph: negative weight %d", weight))
+	}
+	f, t := g.id(from), g.id(to)
+	g.arcs[f] = append(g.arcs[f], arc{t, weight})
+	if !g.directed && f != t {
+		g.arcs[t] = append(g.arcs[t], arc{f, weight})
+	}
+	g.maxWeight = max(g.maxWeight, weight)
+}
+
+// Len returns the number of nodes.
+func (g *Graph[N]) Len() int {
+	return len(g.nodes)
+}
+
+// Has reports whether n is a node of the graph.
+func (g *Graph[N]) Has(n N) bool {
+	_, ok := g.ids[n]
+	return ok
+}
+
+// Nodes returns the nodes in the order they were added.
+func (g *Graph[N]) Nodes() []N {
+	return append([]N(nil), g.nodes...)
+}
+
+// Edges returns every edge once, in the order of their From nodes.
+func (g *Graph[N]) Edges() []Edge[N] {
+	result := make([]Edge[N], 0)
+	for f, arcs := range g.arcs {
+		for _, a := range arcs {
+			if !g.directed && a.to < f {
+				// reported from the other end
+				continue
+			}
+			result = append(result, Edge[N]{g.nodes[f], g.nodes[a.to], a.weight})
+		}
+	}
+	return result
+}
+
+// Neighbours returns the edges leaving n.
+func (g *Graph[N]) Neighbours(n N) []Edge[N] {
+	id, ok := g.ids[n]
+	if !ok {
+		return nil
+	}
+	result := make([]Edge[N], len(g.arcs[id]))
+	for i, a := range g.arcs[id] {
+		result[i] = Edge[N]{n, g.nodes[a.to], a.weight}
+	}
+	return result
+}
+
+// path follows prev back from end to a node without predecessor.
+func (g *Graph[N]) path(prev []int, end, cost int) Path[N] {
+	ids := make([]int, 0)
+	for id := end; id != -1; id = prev[id] {
+		ids = append(ids, id)
+	}
+	nodes := make([]N, len(ids))
+	for i, id := range ids {
+		nodes[len(ids)-1-i] = g.nodes[id]
+	}
+	return Path[N]{nodes, cost}
+}
+
+// predecessors returns a slice of -1 for every node.
+func (g *Graph[N]) predecessors() []int {
+	prev := make([]int, len(g.nodes))
+	for i := range prev {
+		prev[i] = -1
+	}
+	return prev
+}
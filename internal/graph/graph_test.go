@@ -0,0 +1,174 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+type city string
+
+// roads returns a small undirected graph with typed nodes:
+//
+//	a -1- b -1- c
+//	|           |
+//	5           1
+//	|           |
+//	d ----1---- e
+func roads() *Graph[city] {
+	g := NewUndirected[city]()
+	g.AddEdge("a", "b", 1)
+	g.AddEdge("b", "c", 1)
+	g.AddEdge("c", "e", 1)
+	g.AddEdge("a", "d", 5)
+	g.AddEdge("d", "e", 1)
+	return g
+}
+
+func is[N comparable](n N) func(N) bool {
+	return func(m N) bool { return m == n }
+}
+
+func TestEdges(t *testing.T) {
+	t.Parallel()
+	g := roads()
+
+	if got := g.Len(); got != 5 {
+		t.Errorf("want 5 nodes, got %d", got)
+	}
+	if got := len(g.Edges()); got != 5 {
+		t.Errorf("want 5 edges, got %d", got)
+	}
+	if got := len(g.Neighbours("a")); got != 2 {
+		t.Errorf("want 2 neighbours of a, got %d", got)
+	}
+	if g.Has("f") {
+		t.Error("f should not be a node")
+	}
+}
+
+func TestDijkstra(t *testing.T) {
+	t.Parallel()
+	g := roads()
+
+	got, err := Dijkstra(context.Background(), g, []city{"a"}, is[city]("d"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Path[city]{[]city{"a", "b", "c", "e", "d"}, 4}
+	if got.Cost != want.Cost || !slices.Equal(got.Nodes, want.Nodes) {
+		t.Errorf("want %v, got %v", want, got)
+	}
+
+	g.AddNode("f")
+	_, err = Dijkstra(context.Background(), g, []city{"a"}, is[city]("f"))
+	if !errors.Is(err, ErrNoPath) {
+		t.Errorf("want %v, got %v", ErrNoPath, err)
+	}
+}
+
+func TestBFS(t *testing.T) {
+	t.Parallel()
+	g := roads()
+
+	got, err := BFS(context.Background(), g, "a", is[city]("d"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Path[city]{[]city{"a", "d"}, 5}
+	if got.Cost != want.Cost || !slices.Equal(got.Nodes, want.Nodes) {
+		t.Errorf("want %v, got %v", want, got)
+	}
+}
+
+func TestLongestPath(t *testing.T) {
+	t.Parallel()
+	g := roads()
+
+	got, err := LongestPath(context.Background(), g, "a", "c")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Path[city]{[]city{"a", "d", "e", "c"}, 7}
+	if got.Cost != want.Cost || !slices.Equal(got.Nodes, want.Nodes) {
+		t.Errorf("want %v, got %v", want, got)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := LongestPath(ctx, g, "a", "c"); !errors.Is(err, context.Canceled) {
+		t.Errorf("want %v, got %v", context.Canceled, err)
+	}
+}
+
+// barbell returns two complete graphs of size n joined by a single edge.
+func barbell(n int) *Graph[int] {
+	g := NewUndirected[int]()
+	for side := 0; side < 2; side++ {
+		for i := 0; i < n; i++ {
+			for j := i + 1; j < n; j++ {
+				g.AddEdge(side*n+i, side*n+j, 1)
+			}
+		}
+	}
+	g.AddEdge(0, n, 1)
+	return g
+}
+
+func checkBarbellCut(t *testing.T, cut Cut[int], n int) {
+	t.Helper()
+	if cut.Weight != 1 || len(cut.Edges) != 1 {
+		t.Fatalf("want a cut of weight 1, got %d with edges %v", cut.Weight, cut.Edges)
+	}
+	if len(cut.Sides[0]) != n || len(cut.Sides[1]) != n {
+		t.Errorf("want sides of %d nodes, got %v", n, cut.Sides)
+	}
+	if e := cut.Edges[0]; min(e.From, e.To) != 0 || max(e.From, e.To) != n {
+		t.Errorf("want the bridge to be cut, got %v", e)
+	}
+}
+
+func TestKarger(t *testing.T) {
+	t.Parallel()
+	g := barbell(5)
+	rng := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 1000; i++ {
+		if cut := Karger(g, rng); cut.Weight == 1 {
+			checkBarbellCut(t, cut, 5)
+			return
+		}
+	}
+	t.Error("no cut of weight 1 found")
+}
+
+func TestStoerWagner(t *testing.T) {
+	t.Parallel()
+	g := barbell(5)
+
+	cut, err := StoerWagner(context.Background(), g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkBarbellCut(t, cut, 5)
+}
+
+func TestFindCycle(t *testing.T) {
+	t.Parallel()
+	g := NewDirected[string]()
+	g.AddEdge("in", "a", 0)
+	g.AddEdge("a", "b", 0)
+	g.AddEdge("b", "out", 0)
+
+	if cycle, ok := FindCycle(g); ok {
+		t.Errorf("want no cycle, got %v", cycle)
+	}
+
+	g.AddEdge("b", "a", 0)
+	want := []string{"a", "b", "a"}
+	if got, ok := FindCycle(g); !ok || !slices.Equal(got, want) {
+		t.Errorf("want %v, got %v", want, got)
+	}
+}
@@ -0,0 +1,210 @@
+package graph
+
+import (
+	"context"
+	"math"
+)
+
+// Dijkstra returns a cheapest path from any of the start nodes to a node for
+// which goal returns true. Nodes are kept in a bucket queue with one bucket
+// per possible cost modulo the largest edge weight, which suits graphs with
+// small weights such as grid puzzles.
+func Dijkstra[N comparable](ctx context.Context, g *Graph[N], starts []N, goal func(N) bool) (Path[N], error) {
+	type entry struct {
+		id, cost, prev int
+	}
+
+	buckets := make([][]entry, g.maxWeight+1)
+	queued := 0
+	for _, s := range starts {
+		if id, ok := g.ids[s]; ok {
+			buckets[0] = append(buckets[0], entry{id, 0, -1})
+			queued++
+		}
+	}
+
+	dist := make([]int, len(g.nodes))
+	for i := range dist {
+		dist[i] = math.MaxInt
+	}
+	prev := g.predecessors()
+
+	for cost := 0; queued > 0; cost++ {
+		if err := ctx.Err(); err != nil {
+			return Path[N]{}, err
+		}
+
+		index := cost % len(buckets)
+		for len(buckets[index]) > 0 {
+			e := buckets[index][0]
+			buckets[index] = buckets[index][1:]
+			queued--
+
+			if dist[e.id] <= e.cost {
+				continue
+			}
+			dist[e.id] = e.cost
+			prev[e.id] = e.prev
+
+			if goal(g.nodes[e.id]) {
+				return g.path(prev, e.id, e.cost), nil
+			}
+
+			for _, a := range g.arcs[e.id] {
+				c := e.cost + a.weight
+				if c < dist[a.to] {
+					next := c % len(buckets)
+					buckets[next] = append(buckets[next], entry{a.to, c, e.id})
+					queued++
+				}
+			}
+		}
+	}
+
+	return Path[N]{}, ErrNoPath
+}
+
+// BFS returns a path with the fewest edges from start to a node for which
+// goal returns true, ignoring edge weights. The cost of the path is the sum
+// of its weights.
+func BFS[N comparable](ctx context.Context, g *Graph[N], start N, goal func(N) bool) (Path[N], error) {
+	s, ok := g.ids[start]
+	if !ok {
+		return Path[N]{}, ErrNoPath
+	}
+
+	prev := g.predecessors()
+	cost := make([]int, len(g.nodes))
+	seen := make([]bool, len(g.nodes))
+	seen[s] = true
+	for todo := []int{s}; len(todo) > 0; {
+		if err := ctx.Err(); err != nil {
+			return Path[N]{}, err
+		}
+
+		next := make([]int, 0)
+		for _, id := range todo {
+			if goal(g.nodes[id]) {
+				return g.path(prev, id, cost[id]), nil
+			}
+			for _, a := range g.arcs[id] {
+				if seen[a.to] {
+					continue
+				}
+				seen[a.to] = true
+				prev[a.to] = id
+				cost[a.to] = cost[id] + a.weight
+				next = append(next, a.to)
+			}
+		}
+		todo = next
+	}
+
+	return Path[N]{}, ErrNoPath
+}
+
+// LongestPath returns the most expensive path from start to end that visits
+// no node twice. It tries every simple path, so it is only feasible for small
+// graphs, such as graphs reduced to their junctions.
+func LongestPath[N comparable](ctx context.Context, g *Graph[N], start, end N) (Path[N], error) {
+	s, ok := g.ids[start]
+	e, ok2 := g.ids[end]
+	if !ok || !ok2 {
+		return Path[N]{}, ErrNoPath
+	}
+
+	visited := make([]bool, len(g.nodes))
+	current := make([]int, 0, len(g.nodes))
+	best := make([]int, 0)
+	bestCost := -1
+
+	var walk func(id, cost int)
+	walk = func(id, cost int) {
+		if ctx.Err() != nil {
+			return
+		}
+		current = append(current, id)
+		defer func() { current = current[:len(current)-1] }()
+
+		if id == e {
+			if cost > bestCost {
+				bestCost = cost
+				best = append(best[:0], current...)
+			}
+			return
+		}
+
+		visited[id] = true
+		for _, a := range g.arcs[id] {
+			if !visited[a.to] {
+				walk(a.to, cost+a.weight)
+			}
+		}
+		visited[id] = false
+	}
+	walk(s, 0)
+
+	if err := ctx.Err(); err != nil {
+		return Path[N]{}, err
+	}
+	if bestCost < 0 {
+		return Path[N]{}, ErrNoPath
+	}
+
+	nodes := make([]N, len(best))
+	for i, id := range best {
+		nodes[i] = g.nodes[id]
+	}
+	return Path[N]{nodes, bestCost}, nil
+}
+
+// FindCycle returns the nodes of a cycle in a directed graph, starting and
+// ending with the same node, or false when the graph is acyclic. In an
+// undirected graph every edge counts as a cycle of two nodes.
+func FindCycle[N comparable](g *Graph[N]) ([]N, bool) {
+	const (
+		unvisited = iota
+		onStack
+		done
+	)
+
+	state := make([]int, len(g.nodes))
+	stack := make([]int, 0)
+
+	var visit func(id int) []int
+	visit = func(id int) []int {
+		state[id] = onStack
+		stack = append(stack, id)
+		for _, a := range g.arcs[id] {
+			switch state[a.to] {
+			case onStack:
+				for i := len(stack) - 1; i >= 0; i-- {
+					if stack[i] == a.to {
+						return append(append([]int(nil), stack[i:]...), a.to)
+					}
+				}
+			case unvisited:
+				if cycle := visit(a.to); cycle != nil {
+					return cycle
+				}
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[id] = done
+		return nil
+	}
+
+	for id := range g.nodes {
+		if state[id] != unvisited {
+			continue
+		}
+		if cycle := visit(id); cycle != nil {
+			result := make([]N, len(cycle))
+			for i, c := range cycle {
+				result[i] = g.nodes[c]
+			}
+			return result, true
+		}
+	}
+	return nil, false
+}
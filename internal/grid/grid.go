@@ -0,0 +1,215 @@
+// Package grid provides a rectangular two-dimensional grid of cells, along
+// with the points and directions used to walk it.
+package grid
+
+import (
+	"slices"
+
+	"adventofcode23/internal/day"
+)
+
+// Grid is a rectangular grid of cells, stored row by row. Copying a Grid
+// yields a view of the same cells; use Clone for an independent copy.
+type Grid[T any] struct {
+	rows, columns int
+	cells         []T
+}
+
+// New returns a grid of the given size with all cells set to the zero value.
+func New[T any](rows, columns int) Grid[T] {
+	return Grid[T]{rows, columns, make([]T, rows*columns)}
+}
+
+// Parse builds a grid from lines, converting every character with cell.
+// Errors are reported as a *day.ParseError positioned at the offending
+// character; all lines must have the same length.
+func Parse[T any](lines []string, cell func(b byte) (T, error)) (Grid[T], error) {
+	if len(lines) == 0 {
+		return Grid[T]{}, nil
+	}
+
+	g := New[T](len(lines), len(lines[0]))
+	for r, line := range lines {
+		if len(line) != g.columns {
+			err := day.Errorf(0, "row has length %d, want %d", len(line), g.columns)
+			return Grid[T]{}, day.Locate(err, r+1)
+		}
+		for c := 0; c < len(line); c++ {
+			v, err := cell(line[c])
+			if err != nil {
+				return Grid[T]{}, day.Locate(day.Errorf(c+1, "%w", err), r+1)
+			}
+			g.cells[r*g.columns+c] = v
+		}
+	}
+	return g, nil
+}
+
+// Bytes builds a grid of the characters in lines, as returned by
+// day.DayInput.ReadGrid. It panics if the lines are not all the same length.
+func Bytes(lines []string) Grid[byte] {
+	g, err := Parse(lines, func(b byte) (byte, error) { return b, nil })
+	if err != nil {
+		panic(err)
+	}
+	return g
+}
+
+// Rows returns the number of rows.
+func (g Grid[T]) Rows() int {
+	return g.rows
+}
+
+// Columns returns the number of columns.
+func (g Grid[T]) Columns() int {
+	return g.columns
+}
+
+// In reports whether p lies within the grid.
+func (g Grid[T]) In(p Point) bool {
+	return p.Row >= 0 && p.Row < g.rows && p.Column >= 0 && p.Column < g.columns
+}
+
+// Get returns the cell at p, which must lie within the grid.
+func (g Grid[T]) Get(p Point) T {
+	return g.cells[g.index(p)]
+}
+
+// Set sets the cell at p, which must lie within the grid.
+func (g Grid[T]) Set(p Point, v T) {
+	g.cells[g.index(p)] = v
+}
+
+func (g Grid[T]) index(p Point) int {
+	if !g.In(p) {
+		panic("grid: point out of range")
+	}
+	return p.Row*g.columns + p.Column
+}
+
+// Wrap maps p into the grid as if the grid were tiled infinitely in all
+// directions.
+func (g Grid[T]) Wrap(p Point) Point {
+	return Point{mod(p.Row, g.rows), mod(p.Column, g.columns)}
+}
+
+// GetWrapped returns the cell at p of the infinitely tiled grid.
+func (g Grid[T]) GetWrapped(p Point) T {
+	return g.Get(g.Wrap(p))
+}
+
+func mod(a, n int) int {
+	return (a%n + n) % n
+}
+
+// Row returns the cells of row r. The slice shares its cells with the grid.
+func (g Grid[T]) Row(r int) []T {
+	return g.cells[r*g.columns : (r+1)*g.columns : (r+1)*g.columns]
+}
+
+// Column returns a copy of the cells of column c.
+func (g Grid[T]) Column(c int) []T {
+	result := make([]T, g.rows)
+	for r := range result {
+		result[r] = g.cells[r*g.columns+c]
+	}
+	return result
+}
+
+// Cells returns all cells row by row. The slice shares its cells with the
+// grid.
+func (g Grid[T]) Cells() []T {
+	return g.cells
+}
+
+// Neighbours4 returns the orthogonal neighbours of p that lie within the
+// grid.
+func (g Grid[T]) Neighbours4(p Point) []Point {
+	return slices.DeleteFunc(p.Neighbours4(), g.outside)
+}
+
+// Neighbours8 returns the orthogonal and diagonal neighbours of p that lie
+// within the grid.
+func (g Grid[T]) Neighbours8(p Point) []Point {
+	return slices.DeleteFunc(p.Neighbours8(), g.outside)
+}
+
+func (g Grid[T]) outside(p Point) bool {
+	return !g.In(p)
+}
+
+// Find returns the first point, row by row, whose cell satisfies f.
+func (g Grid[T]) Find(f func(T) bool) (Point, bool) {
+	i := slices.IndexFunc(g.cells, f)
+	if i == -1 {
+		return Point{}, false
+	}
+	return Point{i / g.columns, i % g.columns}, true
+}
+
+// Clone returns a copy of g that does not share its cells.
+func (g Grid[T]) Clone() Grid[T] {
+	return Grid[T]{g.rows, g.columns, slices.Clone(g.cells)}
+}
+
+// Pad returns a copy of g surrounded by n rows and columns of fill on every
+// side. Point{n, n} of the result corresponds to the origin of g.
+func (g Grid[T]) Pad(n int, fill T) Grid[T] {
+	result := New[T](g.rows+2*n, g.columns+2*n)
+	for i := range result.cells {
+		result.cells[i] = fill
+	}
+	for r := 0; r < g.rows; r++ {
+		copy(result.Row(r + n)[n:], g.Row(r))
+	}
+	return result
+}
+
+// Transpose returns a copy of g mirrored along its main diagonal.
+func (g Grid[T]) Transpose() Grid[T] {
+	return g.remap(g.columns, g.rows, func(p Point) Point {
+		return Point{p.Column, p.Row}
+	})
+}
+
+// RotateClockwise returns a copy of g turned a quarter clockwise.
+func (g Grid[T]) RotateClockwise() Grid[T] {
+	return g.remap(g.columns, g.rows, func(p Point) Point {
+		return Point{p.Column, g.rows - 1 - p.Row}
+	})
+}
+
+// RotateCounterclockwise returns a copy of g turned a quarter
+// counterclockwise.
+func (g Grid[T]) RotateCounterclockwise() Grid[T] {
+	return g.remap(g.columns, g.rows, func(p Point) Point {
+		return Point{g.columns - 1 - p.Column, p.Row}
+	})
+}
+
+// remap returns a grid of the given size in which the cell at p of g is
+// moved to to(p).
+func (g Grid[T]) remap(rows, columns int, to func(p Point) Point) Grid[T] {
+	result := New[T](rows, columns)
+	for r := 0; r < g.rows; r++ {
+		for c := 0; c < g.columns; c++ {
+			p := Point{r, c}
+			result.Set(to(p), g.Get(p))
+		}
+	}
+	return result
+}
+
+// Equal reports whether a and b have the same size and cells.
+func Equal[T comparable](a, b Grid[T]) bool {
+	return a.rows == b.rows && a.columns == b.columns && slices.Equal(a.cells, b.cells)
+}
+
+// Map returns a grid of the same size as g with f applied to every cell.
+func Map[T, U any](g Grid[T], f func(T) U) Grid[U] {
+	result := New[U](g.rows, g.columns)
+	for i, v := range g.cells {
+		result.cells[i] = f(v)
+	}
+	return result
+}
@@ -0,0 +1,147 @@
+package grid
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	"adventofcode23/internal/day"
+)
+
+func lines(g Grid[byte]) []string {
+	result := make([]string, g.Rows())
+	for r := range result {
+		result[r] = string(g.Row(r))
+	}
+	return result
+}
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+	digit := func(b byte) (int, error) {
+		if b < '0' || b > '9' {
+			return 0, fmt.Errorf("not a digit: %q", b)
+		}
+		return int(b - '0'), nil
+	}
+
+	g, err := Parse([]string{"123", "456"}, digit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.Rows() != 2 || g.Columns() != 3 {
+		t.Errorf("want 2x3, got %dx%d", g.Rows(), g.Columns())
+	}
+	if got := g.Get(Point{1, 2}); got != 6 {
+		t.Errorf("want 6, got %d", got)
+	}
+
+	tests := []struct {
+		lines        []string
+		line, column int
+	}{
+		{[]string{"12", "3"}, 2, 0},
+		{[]string{"12", "3x"}, 2, 2},
+	}
+	for _, tc := range tests {
+		_, err := Parse(tc.lines, digit)
+		var pe *day.ParseError
+		if !errors.As(err, &pe) {
+			t.Fatalf("%q: want a *day.ParseError, got %v", tc.lines, err)
+		}
+		if pe.Line != tc.line || pe.Column != tc.column {
+			t.Errorf("%q: want %d:%d, got %d:%d", tc.lines, tc.line, tc.column, pe.Line, pe.Column)
+		}
+	}
+}
+
+func TestTransformations(t *testing.T) {
+	t.Parallel()
+	g := Bytes([]string{"abc", "def"})
+
+	tests := []struct {
+		name string
+		got  Grid[byte]
+		want []string
+	}{
+		{"transpose", g.Transpose(), []string{"ad", "be", "cf"}},
+		{"clockwise", g.RotateClockwise(), []string{"da", "eb", "fc"}},
+		{"counterclockwise", g.RotateCounterclockwise(), []string{"cf", "be", "ad"}},
+		{"pad", g.Pad(1, '.'), []string{".....", ".abc.", ".def.", "....."}},
+	}
+	for _, tc := range tests {
+		if got := lines(tc.got); !slices.Equal(got, tc.want) {
+			t.Errorf("%s: want %q, got %q", tc.name, tc.want, got)
+		}
+	}
+
+	if !Equal(g, g.RotateClockwise().RotateClockwise().RotateClockwise().RotateClockwise()) {
+		t.Error("four clockwise rotations should restore the grid")
+	}
+	if !Equal(g, g.RotateClockwise().RotateCounterclockwise()) {
+		t.Error("rotating back and forth should restore the grid")
+	}
+}
+
+func TestNeighbours(t *testing.T) {
+	t.Parallel()
+	g := New[int](3, 3)
+
+	tests := []struct {
+		p      Point
+		n4, n8 int
+	}{
+		{Point{0, 0}, 2, 3},
+		{Point{0, 1}, 3, 5},
+		{Point{1, 1}, 4, 8},
+	}
+	for _, tc := range tests {
+		if got := len(g.Neighbours4(tc.p)); got != tc.n4 {
+			t.Errorf("%v: want %d orthogonal neighbours, got %d", tc.p, tc.n4, got)
+		}
+		if got := len(g.Neighbours8(tc.p)); got != tc.n8 {
+			t.Errorf("%v: want %d neighbours, got %d", tc.p, tc.n8, got)
+		}
+	}
+
+	want := []Point{{0, 1}, {1, 2}, {2, 1}, {1, 0}}
+	if got := (Point{1, 1}).Neighbours4(); !slices.Equal(got, want) {
+		t.Errorf("want %v, got %v", want, got)
+	}
+}
+
+func TestWrap(t *testing.T) {
+	t.Parallel()
+	g := Bytes([]string{"ab", "cd", "ef"})
+
+	tests := []struct {
+		p    Point
+		want byte
+	}{
+		{Point{0, 0}, 'a'},
+		{Point{3, 2}, 'a'},
+		{Point{-1, -1}, 'f'},
+		{Point{-4, 5}, 'f'},
+	}
+	for _, tc := range tests {
+		if got := g.GetWrapped(tc.p); got != tc.want {
+			t.Errorf("%v: want %q, got %q", tc.p, tc.want, got)
+		}
+	}
+}
+
+func TestDirection(t *testing.T) {
+	t.Parallel()
+	for _, d := range Directions {
+		if got := d.Clockwise().Counterclockwise(); got != d {
+			t.Errorf("%v: turning right then left gives %v", d, got)
+		}
+		if got := (Point{}).Move(d).Move(d.Reverse()); got != (Point{}) {
+			t.Errorf("%v: moving there and back gives %v", d, got)
+		}
+	}
+	if got := North.Clockwise(); got != East {
+		t.Errorf("want east, got %v", got)
+	}
+}
@@ -0,0 +1,116 @@
+package grid
+
+// Point is a position in a grid. Rows grow downwards, columns to the right.
+type Point struct {
+	Row, Column int
+}
+
+// Add returns the point p moved by the row and column offsets of q.
+func (p Point) Add(q Point) Point {
+	return Point{p.Row + q.Row, p.Column + q.Column}
+}
+
+// Sub returns the offset from q to p.
+func (p Point) Sub(q Point) Point {
+	return Point{p.Row - q.Row, p.Column - q.Column}
+}
+
+// Scale returns p with both coordinates multiplied by n.
+func (p Point) Scale(n int) Point {
+	return Point{p.Row * n, p.Column * n}
+}
+
+// Move returns the point one step from p in direction d.
+func (p Point) Move(d Direction) Point {
+	return p.Add(d.Delta())
+}
+
+// Manhattan returns the taxicab distance between p and q.
+func (p Point) Manhattan(q Point) int {
+	return abs(p.Row-q.Row) + abs(p.Column-q.Column)
+}
+
+// Neighbours4 returns the four orthogonal neighbours of p, in the order of
+// Directions. They are not bounded by any grid.
+func (p Point) Neighbours4() []Point {
+	result := make([]Point, len(Directions))
+	for i, d := range Directions {
+		result[i] = p.Move(d)
+	}
+	return result
+}
+
+// Neighbours8 returns the orthogonal and diagonal neighbours of p, clockwise
+// starting north. They are not bounded by any grid.
+func (p Point) Neighbours8() []Point {
+	result := make([]Point, len(compass))
+	for i, delta := range compass {
+		result[i] = p.Add(delta)
+	}
+	return result
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// Direction is one of the four orthogonal directions.
+type Direction int
+
+const (
+	North Direction = iota
+	East
+	South
+	West
+)
+
+// Directions lists the four directions clockwise, starting north.
+var Directions = []Direction{North, East, South, West}
+
+var (
+	deltas = [...]Point{
+		North: {-1, 0},
+		East:  {0, 1},
+		South: {1, 0},
+		West:  {0, -1},
+	}
+
+	// compass holds the offsets of all eight neighbours, clockwise from north.
+	compass = [...]Point{
+		{-1, 0}, {-1, 1}, {0, 1}, {1, 1}, {1, 0}, {1, -1}, {0, -1}, {-1, -1},
+	}
+
+	directionNames = [...]string{"north", "east", "south", "west"}
+)
+
+// Delta returns the offset of one step in direction d.
+func (d Direction) Delta() Point {
+	return deltas[d]
+}
+
+// Clockwise returns the direction after a right turn.
+func (d Direction) Clockwise() Direction {
+	return (d + 1) % 4
+}
+
+// Counterclockwise returns the direction after a left turn.
+func (d Direction) Counterclockwise() Direction {
+	return (d + 3) % 4
+}
+
+// Reverse returns the opposite direction.
+func (d Direction) Reverse() Direction {
+	return (d + 2) % 4
+}
+
+// Vertical reports whether d is North or South.
+func (d Direction) Vertical() bool {
+	return d == North || d == South
+}
+
+func (d Direction) String() string {
+	return directionNames[d]
+}
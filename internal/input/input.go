@@ -0,0 +1,170 @@
+// Package input downloads puzzle inputs and keeps them in a local cache.
+package input
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultBaseURL = "https://adventofcode.com"
+
+	// DefaultInterval is the minimum time between two requests to the server.
+	DefaultInterval = 3 * time.Second
+
+	// SessionEnv names the environment variable holding the session token.
+	SessionEnv = "AOC_SESSION"
+
+	userAgent = "adventofcode23 input fetcher"
+)
+
+var errNoSession = errors.New("no session token, set " + SessionEnv)
+
+// Fetcher downloads puzzle inputs into a cache directory. Inputs do not change
+// once published, so a cached input is never fetched again. Requests are
+// spaced at least Interval apart to go easy on the server.
+type Fetcher struct {
+	BaseURL  string
+	Session  string
+	CacheDir string
+	Interval time.Duration
+	Client   *http.Client
+
+	mu   sync.Mutex
+	last time.Time
+}
+
+func NewFetcher(baseURL, session, cacheDir string) *Fetcher {
+	return &Fetcher{
+		BaseURL:  strings.TrimRight(baseURL, "/"),
+		Session:  session,
+		CacheDir: cacheDir,
+		Interval: DefaultInterval,
+		Client:   http.DefaultClient,
+	}
+}
+
+// DefaultCacheDir is the cache directory of the current user.
+func DefaultCacheDir() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "adventofcode"), nil
+}
+
+// Path returns the location of the input of year and day in the cache. The
+// directory of a year is laid out like the cmd directory of the source tree,
+// so it can serve as AOC_INPUT_DIR.
+func (f *Fetcher) Path(year, day int) string {
+	return filepath.Join(f.CacheDir, fmt.Sprint(year), fmt.Sprintf("day%02d", day), "input.txt")
+}
+
+// Get returns the path of the cached input of year and day, fetching it first
+// when it is not in the cache yet.
+func (f *Fetcher) Get(ctx context.Context, year, day int) (string, error) {
+	path := f.Path(year, day)
+	if cached(path) {
+		return path, nil
+	}
+
+	// one fetch at a time, so concurrent calls share the rate limit and do not
+	// fetch the same input twice
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if cached(path) {
+		return path, nil
+	}
+	if f.Session == "" {
+		return "", errNoSession
+	}
+	if err := f.wait(ctx); err != nil {
+		return "", err
+	}
+
+	data, err := f.fetch(ctx, year, day)
+	if err != nil {
+		return "", fmt.Errorf("fetching input of %d day %d: %w", year, day, err)
+	}
+	if err := store(path, data); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+func cached(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// wait blocks until Interval has passed since the previous request.
+func (f *Fetcher) wait(ctx context.Context) error {
+	delay := time.Until(f.last.Add(f.Interval))
+	if f.last.IsZero() || delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (f *Fetcher) fetch(ctx context.Context, year, day int) ([]byte, error) {
+	url := fmt.Sprintf("%s/%d/day/%d/input", f.BaseURL, year, day)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.AddCookie(&http.Cookie{Name: "session", Value: f.Session})
+	req.Header.Set("User-Agent", userAgent)
+
+	f.last = time.Now()
+	resp, err := f.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("server responded %s", resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// store writes data to path through a temporary file, so that an interrupted
+// download never leaves a partial input in the cache.
+func store(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".input-*")
+	if err != nil {
+		return err
+	}
+	_, err = tmp.Write(data)
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), path)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+	}
+	return err
+}
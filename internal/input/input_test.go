@@ -0,0 +1,126 @@
+package input
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// standIn serves inputs like the puzzle site, counting the requests it gets.
+func standIn(t *testing.T, requests *atomic.Int32) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		cookie, err := r.Cookie("session")
+		if err != nil || cookie.Value != "secret" {
+			http.Error(w, "log in first", http.StatusBadRequest)
+			return
+		}
+		var year, day int
+		if _, err := fmt.Sscanf(r.URL.Path, "/%d/day/%d/input", &year, &day); err != nil || day > 25 {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprintf(w, "input of %d day %d\n", year, day)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestGet(t *testing.T) {
+	t.Parallel()
+	var requests atomic.Int32
+	srv := standIn(t, &requests)
+	f := NewFetcher(srv.URL+"/", "secret", t.TempDir())
+	f.Interval = 0
+
+	for i := 0; i < 2; i++ {
+		path, err := f.Get(context.Background(), 2023, 5)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if path != f.Path(2023, 5) {
+			t.Errorf("want %s, got %s", f.Path(2023, 5), path)
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := "input of 2023 day 5\n"; want != string(data) {
+			t.Errorf("want %q, got %q", want, data)
+		}
+	}
+
+	if got := requests.Load(); got != 1 {
+		t.Errorf("want 1 request, got %d", got)
+	}
+}
+
+func TestGetError(t *testing.T) {
+	t.Parallel()
+	var requests atomic.Int32
+	srv := standIn(t, &requests)
+
+	tests := []struct {
+		session string
+		day     int
+	}{
+		{"wrong", 5},
+		{"secret", 26},
+	}
+
+	for _, test := range tests {
+		f := NewFetcher(srv.URL, test.session, t.TempDir())
+		f.Interval = 0
+		if _, err := f.Get(context.Background(), 2023, test.day); err == nil {
+			t.Errorf("session %s, day %d: want error, got none", test.session, test.day)
+		}
+		if cached(f.Path(2023, test.day)) {
+			t.Errorf("session %s, day %d: failed fetch was cached", test.session, test.day)
+		}
+	}
+}
+
+func TestNoSession(t *testing.T) {
+	t.Parallel()
+	var requests atomic.Int32
+	srv := standIn(t, &requests)
+	f := NewFetcher(srv.URL, "", t.TempDir())
+
+	if _, err := f.Get(context.Background(), 2023, 1); err != errNoSession {
+		t.Errorf("want %v, got %v", errNoSession, err)
+	}
+	if got := requests.Load(); got != 0 {
+		t.Errorf("want no requests, got %d", got)
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	t.Parallel()
+	var requests atomic.Int32
+	srv := standIn(t, &requests)
+	f := NewFetcher(srv.URL, "secret", t.TempDir())
+	f.Interval = 50 * time.Millisecond
+
+	start := time.Now()
+	for day := 1; day <= 3; day++ {
+		if _, err := f.Get(context.Background(), 2023, day); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 2*f.Interval {
+		t.Errorf("3 requests took %v, want at least %v", elapsed, 2*f.Interval)
+	}
+
+	// waiting for the next slot honors the context
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := f.Get(ctx, 2023, 4); err != context.Canceled {
+		t.Errorf("want %v, got %v", context.Canceled, err)
+	}
+}
@@ -0,0 +1,223 @@
+// Package interval provides arithmetic on half-open integer intervals, sets
+// of them, and piecewise-linear maps between them.
+package interval
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Interval is the half-open range of integers [Start, End). It is empty when
+// End <= Start.
+type Interval struct {
+	Start, End int
+}
+
+// Closed returns the interval of the integers from lo to hi, inclusive.
+func Closed(lo, hi int) Interval {
+	return Interval{lo, hi + 1}
+}
+
+// Len returns the number of integers in i.
+func (i Interval) Len() int {
+	if i.Empty() {
+		return 0
+	}
+	return i.End - i.Start
+}
+
+// Empty reports whether i contains no integers.
+func (i Interval) Empty() bool {
+	return i.End <= i.Start
+}
+
+// Contains reports whether v lies in i.
+func (i Interval) Contains(v int) bool {
+	return v >= i.Start && v < i.End
+}
+
+// Intersect returns the integers in both i and j. The result may be empty.
+func (i Interval) Intersect(j Interval) Interval {
+	return Interval{max(i.Start, j.Start), min(i.End, j.End)}
+}
+
+// Overlaps reports whether i and j have an integer in common.
+func (i Interval) Overlaps(j Interval) bool {
+	return !i.Intersect(j).Empty()
+}
+
+// Shift returns i moved by d.
+func (i Interval) Shift(d int) Interval {
+	return Interval{i.Start + d, i.End + d}
+}
+
+// Split cuts i at the given points, in any order, and returns the non-empty
+// pieces in ascending order.
+func (i Interval) Split(points ...int) []Interval {
+	if i.Empty() {
+		return nil
+	}
+	points = slices.Clone(points)
+	slices.Sort(points)
+
+	result := make([]Interval, 0, len(points)+1)
+	start := i.Start
+	for _, p := range points {
+		if p <= start || p >= i.End {
+			continue
+		}
+		result = append(result, Interval{start, p})
+		start = p
+	}
+	return append(result, Interval{start, i.End})
+}
+
+func (i Interval) String() string {
+	return fmt.Sprintf("[%d,%d)", i.Start, i.End)
+}
+
+// Set is a set of integers kept as sorted, disjoint, non-adjacent intervals.
+// The zero Set is empty.
+type Set struct {
+	intervals []Interval
+}
+
+// NewSet returns the union of the given intervals.
+func NewSet(intervals ...Interval) Set {
+	sorted := make([]Interval, 0, len(intervals))
+	for _, i := range intervals {
+		if !i.Empty() {
+			sorted = append(sorted, i)
+		}
+	}
+	slices.SortFunc(sorted, func(a, b Interval) int {
+		return cmp.Compare(a.Start, b.Start)
+	})
+
+	result := make([]Interval, 0, len(sorted))
+	for _, i := range sorted {
+		if n := len(result); n > 0 && i.Start <= result[n-1].End {
+			result[n-1].End = max(result[n-1].End, i.End)
+			continue
+		}
+		result = append(result, i)
+	}
+	return Set{result}
+}
+
+// Intervals returns the intervals of s in ascending order.
+func (s Set) Intervals() []Interval {
+	return slices.Clone(s.intervals)
+}
+
+// Len returns the number of integers in s.
+func (s Set) Len() int {
+	result := 0
+	for _, i := range s.intervals {
+		result += i.Len()
+	}
+	return result
+}
+
+// Empty reports whether s contains no integers.
+func (s Set) Empty() bool {
+	return len(s.intervals) == 0
+}
+
+// Contains reports whether v lies in s.
+func (s Set) Contains(v int) bool {
+	i, found := slices.BinarySearchFunc(s.intervals, v, func(i Interval, v int) int {
+		return cmp.Compare(i.Start, v)
+	})
+	if found {
+		return true
+	}
+	return i > 0 && s.intervals[i-1].Contains(v)
+}
+
+// Min returns the smallest integer in s, or false if s is empty.
+func (s Set) Min() (int, bool) {
+	if s.Empty() {
+		return 0, false
+	}
+	return s.intervals[0].Start, true
+}
+
+// Union returns the integers in s or t.
+func (s Set) Union(t Set) Set {
+	return NewSet(append(slices.Clone(s.intervals), t.intervals...)...)
+}
+
+// Intersect returns the integers in both s and t.
+func (s Set) Intersect(t Set) Set {
+	result := make([]Interval, 0)
+	for a, b := 0, 0; a < len(s.intervals) && b < len(t.intervals); {
+		i, j := s.intervals[a], t.intervals[b]
+		if k := i.Intersect(j); !k.Empty() {
+			result = append(result, k)
+		}
+		if i.End < j.End {
+			a++
+		} else {
+			b++
+		}
+	}
+	return Set{result}
+}
+
+// Difference returns the integers in s but not in t.
+func (s Set) Difference(t Set) Set {
+	result := make([]Interval, 0)
+	b := 0
+	for _, i := range s.intervals {
+		for b < len(t.intervals) && t.intervals[b].End <= i.Start {
+			b++
+		}
+		start := i.Start
+		for c := b; c < len(t.intervals) && t.intervals[c].Start < i.End; c++ {
+			j := t.intervals[c]
+			if j.Start > start {
+				result = append(result, Interval{start, j.Start})
+			}
+			start = max(start, j.End)
+		}
+		if start < i.End {
+			result = append(result, Interval{start, i.End})
+		}
+	}
+	return Set{result}
+}
+
+// Shift returns s with every integer moved by d.
+func (s Set) Shift(d int) Set {
+	result := make([]Interval, len(s.intervals))
+	for n, i := range s.intervals {
+		result[n] = i.Shift(d)
+	}
+	return Set{result}
+}
+
+// Split cuts the intervals of s at the given points and returns the pieces
+// in ascending order.
+func (s Set) Split(points ...int) []Interval {
+	result := make([]Interval, 0, len(s.intervals))
+	for _, i := range s.intervals {
+		result = append(result, i.Split(points...)...)
+	}
+	return result
+}
+
+// Equal reports whether s and t contain the same integers.
+func (s Set) Equal(t Set) bool {
+	return slices.Equal(s.intervals, t.intervals)
+}
+
+func (s Set) String() string {
+	parts := make([]string, len(s.intervals))
+	for n, i := range s.intervals {
+		parts[n] = i.String()
+	}
+	return "{" + strings.Join(parts, " ") + "}"
+}
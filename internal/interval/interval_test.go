@@ -0,0 +1,183 @@
+package interval
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+// The properties below are checked against a model that simply lists the
+// integers of a small domain.
+const (
+	lo, hi = -20, 20
+	rounds = 1000
+)
+
+type model map[int]bool
+
+func randomInterval(rng *rand.Rand) Interval {
+	start := lo + rng.Intn(hi-lo)
+	return Interval{start, start + rng.Intn(8)}
+}
+
+func randomSet(rng *rand.Rand) (Set, model) {
+	intervals := make([]Interval, rng.Intn(5))
+	m := make(model)
+	for i := range intervals {
+		intervals[i] = randomInterval(rng)
+		for v := intervals[i].Start; v < intervals[i].End; v++ {
+			m[v] = true
+		}
+	}
+	return NewSet(intervals...), m
+}
+
+func checkSet(t *testing.T, name string, got Set, want model) {
+	t.Helper()
+	for i, in := range got.intervals {
+		if in.Empty() {
+			t.Fatalf("%s: %v holds an empty interval", name, got)
+		}
+		if i > 0 && got.intervals[i-1].End >= in.Start {
+			t.Fatalf("%s: %v is not sorted, disjoint and non-adjacent", name, got)
+		}
+	}
+	for v := 2 * lo; v < 2*hi; v++ {
+		if got.Contains(v) != want[v] {
+			t.Fatalf("%s: %v contains %d: want %t", name, got, v, want[v])
+		}
+	}
+	if got.Len() != len(want) {
+		t.Fatalf("%s: %v has length %d, want %d", name, got, got.Len(), len(want))
+	}
+}
+
+func TestSetProperties(t *testing.T) {
+	t.Parallel()
+	rng := rand.New(rand.NewSource(1))
+
+	for r := 0; r < rounds; r++ {
+		s, ms := randomSet(rng)
+		u, mu := randomSet(rng)
+		checkSet(t, "new", s, ms)
+
+		union, intersection, difference := make(model), make(model), make(model)
+		for v := range ms {
+			union[v] = true
+			if mu[v] {
+				intersection[v] = true
+			} else {
+				difference[v] = true
+			}
+		}
+		for v := range mu {
+			union[v] = true
+		}
+		checkSet(t, "union", s.Union(u), union)
+		checkSet(t, "intersection", s.Intersect(u), intersection)
+		checkSet(t, "difference", s.Difference(u), difference)
+
+		d := rng.Intn(11) - 5
+		shifted := make(model)
+		for v := range ms {
+			shifted[v+d] = true
+		}
+		checkSet(t, "shift", s.Shift(d), shifted)
+
+		if !s.Difference(u).Union(s.Intersect(u)).Equal(s) {
+			t.Fatalf("(%v \\ %v) ∪ (%v ∩ %v) differs from %v", s, u, s, u, s)
+		}
+	}
+}
+
+func TestSplit(t *testing.T) {
+	t.Parallel()
+	rng := rand.New(rand.NewSource(2))
+
+	for r := 0; r < rounds; r++ {
+		s, ms := randomSet(rng)
+		points := []int{rng.Intn(hi-lo) + lo, rng.Intn(hi-lo) + lo}
+
+		pieces := s.Split(points...)
+		checkSet(t, "split", NewSet(pieces...), ms)
+		for _, p := range pieces {
+			for _, point := range points {
+				if point > p.Start && point < p.End {
+					t.Fatalf("%v is not split at %d", p, point)
+				}
+			}
+		}
+	}
+}
+
+func randomMap(rng *rand.Rand) Map {
+	pieces := make([]Piece, 0)
+	start := lo
+	for start < hi {
+		start += rng.Intn(6)
+		p := Piece{Interval{start, start + rng.Intn(6)}, rng.Intn(21) - 10}
+		pieces = append(pieces, p)
+		start = p.End
+	}
+	m, err := NewMap(pieces...)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// apply maps v by searching the pieces one by one.
+func apply(m Map, v int) int {
+	for _, p := range m.pieces {
+		if p.Contains(v) {
+			return v + p.Offset
+		}
+	}
+	return v
+}
+
+func TestMapProperties(t *testing.T) {
+	t.Parallel()
+	rng := rand.New(rand.NewSource(3))
+
+	for r := 0; r < rounds; r++ {
+		m, n := randomMap(rng), randomMap(rng)
+		composed := m.Then(n)
+		for v := 2 * lo; v < 2*hi; v++ {
+			if got, want := m.Apply(v), apply(m, v); got != want {
+				t.Fatalf("%v maps %d to %d, want %d", m.pieces, v, got, want)
+			}
+			if got, want := composed.Apply(v), n.Apply(m.Apply(v)); got != want {
+				t.Fatalf("composition maps %d to %d, want %d", v, got, want)
+			}
+		}
+
+		s, ms := randomSet(rng)
+		image := make(model)
+		for v := range ms {
+			image[m.Apply(v)] = true
+		}
+		checkSet(t, "image", m.Image(s), image)
+	}
+}
+
+func TestNewMap(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewMap(Piece{Interval{0, 5}, 1}, Piece{Interval{4, 8}, 2})
+	if !errors.Is(err, errOverlap) {
+		t.Errorf("want %v, got %v", errOverlap, err)
+	}
+
+	a, err := NewMap(Piece{Interval{0, 5}, 1}, Piece{Interval{5, 8}, 1}, Piece{Interval{8, 9}, 0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := NewMap(Piece{Interval{0, 8}, 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !a.Equal(b) {
+		t.Errorf("want %v, got %v", b.pieces, a.pieces)
+	}
+}
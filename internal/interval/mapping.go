@@ -0,0 +1,133 @@
+package interval
+
+import (
+	"cmp"
+	"errors"
+	"math"
+	"slices"
+)
+
+var errOverlap = errors.New("interval: pieces of a map overlap")
+
+// Piece maps the integers of an interval by adding Offset to them.
+type Piece struct {
+	Interval
+	Offset int
+}
+
+// Map is a piecewise-linear map of the integers: within each of its pieces,
+// values are shifted by the piece's offset, everywhere else they map to
+// themselves.
+type Map struct {
+	pieces []Piece // sorted, disjoint, non-empty, without zero offsets
+}
+
+// NewMap returns the map made up of the given pieces, which must not overlap.
+func NewMap(pieces ...Piece) (Map, error) {
+	sorted := make([]Piece, 0, len(pieces))
+	for _, p := range pieces {
+		if !p.Empty() {
+			sorted = append(sorted, p)
+		}
+	}
+	slices.SortFunc(sorted, func(a, b Piece) int {
+		return cmp.Compare(a.Start, b.Start)
+	})
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i].Start < sorted[i-1].End {
+			return Map{}, errOverlap
+		}
+	}
+	return Map{normalize(sorted)}, nil
+}
+
+// normalize drops identity pieces and joins adjacent pieces with the same
+// offset, so that equal maps have equal pieces.
+func normalize(pieces []Piece) []Piece {
+	result := make([]Piece, 0, len(pieces))
+	for _, p := range pieces {
+		if p.Offset == 0 || p.Empty() {
+			continue
+		}
+		if n := len(result); n > 0 && result[n-1].End == p.Start && result[n-1].Offset == p.Offset {
+			result[n-1].End = p.End
+			continue
+		}
+		result = append(result, p)
+	}
+	return result
+}
+
+// Pieces returns the pieces of m that move their values, in ascending order.
+func (m Map) Pieces() []Piece {
+	return slices.Clone(m.pieces)
+}
+
+// Apply returns the image of v under m.
+func (m Map) Apply(v int) int {
+	i, found := slices.BinarySearchFunc(m.pieces, v, func(p Piece, v int) int {
+		return cmp.Compare(p.Start, v)
+	})
+	if !found {
+		i--
+	}
+	if i >= 0 && m.pieces[i].Contains(v) {
+		return v + m.pieces[i].Offset
+	}
+	return v
+}
+
+// segments returns pieces covering all integers but math.MaxInt, including
+// those that map to themselves.
+func (m Map) segments() []Piece {
+	result := make([]Piece, 0, 2*len(m.pieces)+1)
+	start := math.MinInt
+	for _, p := range m.pieces {
+		if p.Start > start {
+			result = append(result, Piece{Interval{start, p.Start}, 0})
+		}
+		result = append(result, p)
+		start = p.End
+	}
+	if start < math.MaxInt {
+		result = append(result, Piece{Interval{start, math.MaxInt}, 0})
+	}
+	return result
+}
+
+// boundaries returns the points where the pieces of m start and end.
+func (m Map) boundaries() []int {
+	result := make([]int, 0, 2*len(m.pieces))
+	for _, p := range m.pieces {
+		result = append(result, p.Start, p.End)
+	}
+	return result
+}
+
+// Image returns the set of the images under m of the integers in s.
+func (m Map) Image(s Set) Set {
+	result := make([]Interval, 0)
+	for _, i := range s.Split(m.boundaries()...) {
+		result = append(result, i.Shift(m.Apply(i.Start)-i.Start))
+	}
+	return NewSet(result...)
+}
+
+// Then returns the map that applies m first and n to its result, so that
+// m.Then(n).Apply(v) == n.Apply(m.Apply(v)).
+func (m Map) Then(n Map) Map {
+	result := make([]Piece, 0)
+	bounds := n.boundaries()
+	for _, s := range m.segments() {
+		// split the image of each segment where the pieces of n change
+		for _, i := range s.Shift(s.Offset).Split(bounds...) {
+			result = append(result, Piece{i.Shift(-s.Offset), s.Offset + n.Apply(i.Start) - i.Start})
+		}
+	}
+	return Map{normalize(result)}
+}
+
+// Equal reports whether m and n map every integer alike.
+func (m Map) Equal(n Map) bool {
+	return slices.Equal(m.pieces, n.pieces)
+}
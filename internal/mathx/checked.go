@@ -0,0 +1,108 @@
+// Package mathx provides integer and exact rational arithmetic that the
+// standard library lacks: overflow-checked operations, number theory,
+// interpolation, linear systems and lattice polygons.
+package mathx
+
+import (
+	"errors"
+	"math"
+	"math/bits"
+)
+
+var (
+	// ErrOverflow is returned when a result does not fit in an int.
+	ErrOverflow = errors.New("mathx: integer overflow")
+	// ErrNoSolution is returned for systems without a solution.
+	ErrNoSolution = errors.New("mathx: no solution")
+)
+
+// Add returns a + b, or false if the sum overflows.
+func Add(a, b int) (int, bool) {
+	c := a + b
+	if (c > a) != (b > 0) {
+		return c, false
+	}
+	return c, true
+}
+
+// Sub returns a - b, or false if the difference overflows.
+func Sub(a, b int) (int, bool) {
+	c := a - b
+	if (c < a) != (b > 0) {
+		return c, false
+	}
+	return c, true
+}
+
+// Mul returns a * b, or false if the product overflows.
+func Mul(a, b int) (int, bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+	c := a * b
+	if (c < 0) != ((a < 0) != (b < 0)) || c/b != a {
+		return c, false
+	}
+	return c, true
+}
+
+// Pow returns base raised to exp, which must not be negative, or false if the
+// power overflows.
+func Pow(base, exp int) (int, bool) {
+	result := 1
+	ok := true
+	for ; exp > 0; exp >>= 1 {
+		if exp&1 == 1 {
+			var fits bool
+			result, fits = Mul(result, base)
+			ok = ok && fits
+		}
+		if exp > 1 {
+			var fits bool
+			base, fits = Mul(base, base)
+			ok = ok && fits
+		}
+	}
+	return result, ok
+}
+
+// Abs returns the absolute value of a. Abs(math.MinInt) overflows and
+// returns math.MinInt.
+func Abs(a int) int {
+	if a < 0 {
+		return -a
+	}
+	return a
+}
+
+// ISqrt returns the largest integer whose square is at most n, which must not
+// be negative.
+func ISqrt(n int) int {
+	if n < 0 {
+		panic("mathx: square root of negative number")
+	}
+	// start from the floating point estimate and correct its rounding
+	r := int(math.Sqrt(float64(n)))
+	for r > 0 && !fitsSquare(r, n) {
+		r--
+	}
+	for fitsSquare(r+1, n) {
+		r++
+	}
+	return r
+}
+
+// fitsSquare reports whether r² <= n.
+func fitsSquare(r, n int) bool {
+	hi, lo := bits.Mul64(uint64(r), uint64(r))
+	return hi == 0 && lo <= uint64(n)
+}
+
+// IsSquare reports whether n is the square of an integer.
+func IsSquare(n int) bool {
+	if n < 0 {
+		return false
+	}
+	r := ISqrt(n)
+	return r*r == n
+}
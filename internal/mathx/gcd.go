@@ -0,0 +1,105 @@
+package mathx
+
+import (
+	"math/big"
+)
+
+// GCD returns the greatest common divisor of a and b, which is never
+// negative. GCD(0, 0) is 0.
+func GCD(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return Abs(a)
+}
+
+// LCM returns the least common multiple of all numbers, or false if it
+// overflows.
+func LCM(a int, rest ...int) (int, bool) {
+	result := Abs(a)
+	for _, b := range rest {
+		if result == 0 || b == 0 {
+			result = 0
+			continue
+		}
+		var ok bool
+		result, ok = Mul(result/GCD(result, b), Abs(b))
+		if !ok {
+			return 0, false
+		}
+	}
+	return result, true
+}
+
+// ExtendedGCD returns g = GCD(a, b) together with Bézout coefficients x and y
+// such that a*x + b*y = g.
+func ExtendedGCD(a, b int) (g, x, y int) {
+	oldR, r := a, b
+	oldX, x := 1, 0
+	oldY, y := 0, 1
+	for r != 0 {
+		q := oldR / r
+		oldR, r = r, oldR-q*r
+		oldX, x = x, oldX-q*x
+		oldY, y = y, oldY-q*y
+	}
+	if oldR < 0 {
+		return -oldR, -oldX, -oldY
+	}
+	return oldR, oldX, oldY
+}
+
+// ModInverse returns the x in [0, m) with a*x ≡ 1 (mod m), or false if a and
+// m are not coprime.
+func ModInverse(a, m int) (int, bool) {
+	g, x, _ := ExtendedGCD(a, m)
+	if g != 1 {
+		return 0, false
+	}
+	return Mod(x, m), true
+}
+
+// Mod returns a modulo m in [0, |m|).
+func Mod(a, m int) int {
+	m = Abs(m)
+	return (a%m + m) % m
+}
+
+// CRT solves the system x ≡ residues[i] (mod moduli[i]) by the Chinese
+// remainder theorem. The moduli need not be coprime. It returns the smallest
+// non-negative solution x and the modulus of all solutions, the least common
+// multiple of the moduli. The error is ErrNoSolution if the congruences
+// contradict each other and ErrOverflow if the modulus does not fit in an
+// int.
+func CRT(residues, moduli []int) (x, m int, err error) {
+	if len(residues) != len(moduli) {
+		panic("mathx: CRT needs as many residues as moduli")
+	}
+
+	r, n := big.NewInt(0), big.NewInt(1)
+	g, p, q := new(big.Int), new(big.Int), new(big.Int)
+	for i, mi := range moduli {
+		if mi == 0 {
+			panic("mathx: CRT modulus 0")
+		}
+		ri := big.NewInt(int64(Mod(residues[i], mi)))
+		bm := big.NewInt(int64(Abs(mi)))
+
+		// n*p + bm*q = g; the combined solution is r + n*p*(ri-r)/g
+		g.GCD(p, q, n, bm)
+		diff := new(big.Int).Sub(ri, r)
+		if new(big.Int).Mod(diff, g).Sign() != 0 {
+			return 0, 0, ErrNoSolution
+		}
+		diff.Quo(diff, g)
+		step := new(big.Int).Mul(n, p)
+		step.Mul(step, diff)
+		n.Mul(n, new(big.Int).Quo(bm, g))
+		r.Add(r, step).Mod(r, n)
+	}
+
+	if !n.IsInt64() || !r.IsInt64() {
+		return 0, 0, ErrOverflow
+	}
+	return int(r.Int64()), int(n.Int64()), nil
+}
@@ -0,0 +1,75 @@
+package mathx
+
+import (
+	"errors"
+	"math/big"
+)
+
+var errDuplicateX = errors.New("mathx: points share an x coordinate")
+
+// Interpolate evaluates at x the polynomial of least degree through the points
+// (xs[i], ys[i]), using Lagrange's formula with exact rational arithmetic.
+// With n points the polynomial has degree at most n-1.
+func Interpolate(xs, ys []int, x int) (*big.Rat, error) {
+	if len(xs) != len(ys) {
+		panic("mathx: Interpolate needs as many xs as ys")
+	}
+
+	result := new(big.Rat)
+	for i, xi := range xs {
+		term := new(big.Rat).SetInt64(int64(ys[i]))
+		for j, xj := range xs {
+			if j == i {
+				continue
+			}
+			if xj == xi {
+				return nil, errDuplicateX
+			}
+			term.Mul(term, big.NewRat(int64(x-xj), int64(xi-xj)))
+		}
+		result.Add(result, term)
+	}
+	return result, nil
+}
+
+// InterpolateInt is Interpolate for polynomials that take an integer value at
+// x. The error is ErrNoSolution if the value is not an integer and
+// ErrOverflow if it does not fit in an int.
+func InterpolateInt(xs, ys []int, x int) (int, error) {
+	v, err := Interpolate(xs, ys, x)
+	if err != nil {
+		return 0, err
+	}
+	return RatInt(v)
+}
+
+// RatInt converts v to an int. The error is ErrNoSolution if v is not an
+// integer and ErrOverflow if it does not fit in an int.
+func RatInt(v *big.Rat) (int, error) {
+	if !v.IsInt() {
+		return 0, ErrNoSolution
+	}
+	if !v.Num().IsInt64() {
+		return 0, ErrOverflow
+	}
+	return int(v.Num().Int64()), nil
+}
+
+// Binomial returns n choose k, or false if it overflows.
+func Binomial(n, k int) (int, bool) {
+	if k < 0 || k > n {
+		return 0, true
+	}
+	k = min(k, n-k)
+	result := 1
+	for i := 1; i <= k; i++ {
+		// result * (n-k+i) is divisible by i after the multiplication
+		g := GCD(result, i)
+		var ok bool
+		result, ok = Mul(result/g, (n-k+i)/(i/g))
+		if !ok {
+			return 0, false
+		}
+	}
+	return result, true
+}
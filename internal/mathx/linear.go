@@ -0,0 +1,80 @@
+package mathx
+
+import (
+	"errors"
+	"math/big"
+)
+
+// ErrSingular is returned for linear systems without a unique solution.
+var ErrSingular = errors.New("mathx: singular system")
+
+// Solve returns the unique x with a·x = b, computed exactly by Gaussian
+// elimination. a must be square with as many rows as b; neither is modified.
+func Solve(a [][]*big.Rat, b []*big.Rat) ([]*big.Rat, error) {
+	n := len(b)
+	if len(a) != n {
+		panic("mathx: Solve needs as many rows as right-hand sides")
+	}
+
+	// augmented copy of the system
+	m := make([][]*big.Rat, n)
+	for i, row := range a {
+		if len(row) != n {
+			panic("mathx: Solve needs a square matrix")
+		}
+		m[i] = make([]*big.Rat, n+1)
+		for j, v := range row {
+			m[i][j] = new(big.Rat).Set(v)
+		}
+		m[i][n] = new(big.Rat).Set(b[i])
+	}
+
+	t := new(big.Rat)
+	for col := 0; col < n; col++ {
+		pivot := -1
+		for row := col; row < n; row++ {
+			if m[row][col].Sign() != 0 {
+				pivot = row
+				break
+			}
+		}
+		if pivot == -1 {
+			return nil, ErrSingular
+		}
+		m[col], m[pivot] = m[pivot], m[col]
+
+		for row := 0; row < n; row++ {
+			if row == col || m[row][col].Sign() == 0 {
+				continue
+			}
+			f := new(big.Rat).Quo(m[row][col], m[col][col])
+			for j := col; j <= n; j++ {
+				m[row][j].Sub(m[row][j], t.Mul(f, m[col][j]))
+			}
+		}
+	}
+
+	x := make([]*big.Rat, n)
+	for i := range x {
+		x[i] = new(big.Rat).Quo(m[i][n], m[i][i])
+	}
+	return x, nil
+}
+
+// IntMatrix converts rows of ints to a matrix for Solve.
+func IntMatrix(rows ...[]int) [][]*big.Rat {
+	result := make([][]*big.Rat, len(rows))
+	for i, row := range rows {
+		result[i] = IntVector(row...)
+	}
+	return result
+}
+
+// IntVector converts ints to a vector for Solve.
+func IntVector(v ...int) []*big.Rat {
+	result := make([]*big.Rat, len(v))
+	for i, x := range v {
+		result[i] = new(big.Rat).SetInt64(int64(x))
+	}
+	return result
+}
@@ -0,0 +1,191 @@
+package mathx
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+
+	"adventofcode23/internal/grid"
+)
+
+func TestChecked(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		op   func(a, b int) (int, bool)
+		a, b int
+		want int
+		ok   bool
+	}{
+		{"add", Add, 2, 3, 5, true},
+		{"add", Add, math.MaxInt, 1, 0, false},
+		{"add", Add, math.MinInt, -1, 0, false},
+		{"sub", Sub, 2, 3, -1, true},
+		{"sub", Sub, math.MinInt, 1, 0, false},
+		{"sub", Sub, 0, math.MinInt, 0, false},
+		{"mul", Mul, -4, 5, -20, true},
+		{"mul", Mul, math.MaxInt/2 + 1, 2, 0, false},
+		{"mul", Mul, math.MinInt, -1, 0, false},
+		{"mul", Mul, -1, math.MinInt, 0, false},
+		{"pow", Pow, 3, 4, 81, true},
+		{"pow", Pow, 2, 63, 0, false},
+		{"pow", Pow, 2, 62, 1 << 62, true},
+	}
+	for _, tc := range tests {
+		got, ok := tc.op(tc.a, tc.b)
+		if ok != tc.ok || ok && got != tc.want {
+			t.Errorf("%s(%d, %d): want %d, %t, got %d, %t", tc.name, tc.a, tc.b, tc.want, tc.ok, got, ok)
+		}
+	}
+}
+
+func TestISqrt(t *testing.T) {
+	t.Parallel()
+	for _, n := range []int{0, 1, 2, 3, 4, 15, 16, 17, 1<<52 + 1, math.MaxInt} {
+		r := ISqrt(n)
+		if r*r > n || (r+1)*(r+1) <= n && (r+1)*(r+1) > 0 {
+			t.Errorf("ISqrt(%d) = %d", n, r)
+		}
+	}
+	if want := 3037000499; ISqrt(math.MaxInt) != want {
+		t.Errorf("want %d, got %d", want, ISqrt(math.MaxInt))
+	}
+	if !IsSquare(1<<60) || IsSquare(1<<60+1) {
+		t.Error("IsSquare misjudges 2^60 or 2^60+1")
+	}
+}
+
+func TestGCD(t *testing.T) {
+	t.Parallel()
+	if got := GCD(-12, 18); got != 6 {
+		t.Errorf("want 6, got %d", got)
+	}
+	if got, ok := LCM(4, 6, 10); !ok || got != 60 {
+		t.Errorf("want 60, got %d", got)
+	}
+	if _, ok := LCM(math.MaxInt, math.MaxInt-1); ok {
+		t.Error("want overflow")
+	}
+
+	for _, tc := range [][2]int{{240, 46}, {-7, 3}, {0, 5}, {17, 0}} {
+		g, x, y := ExtendedGCD(tc[0], tc[1])
+		if g != GCD(tc[0], tc[1]) || tc[0]*x+tc[1]*y != g {
+			t.Errorf("ExtendedGCD(%d, %d) = %d, %d, %d", tc[0], tc[1], g, x, y)
+		}
+	}
+
+	if inv, ok := ModInverse(3, 11); !ok || inv != 4 {
+		t.Errorf("want 4, got %d", inv)
+	}
+	if _, ok := ModInverse(4, 8); ok {
+		t.Error("4 has no inverse modulo 8")
+	}
+}
+
+func TestCRT(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		residues, moduli []int
+		x, m             int
+		err              error
+	}{
+		{[]int{2, 3, 2}, []int{3, 5, 7}, 23, 105, nil},
+		{[]int{3, 5}, []int{4, 6}, 11, 12, nil}, // not coprime
+		{[]int{1, 2}, []int{4, 6}, 0, 0, ErrNoSolution},
+		{[]int{-1}, []int{5}, 4, 5, nil},
+		{[]int{0, 0}, []int{1 << 40, 1<<40 - 1}, 0, 0, ErrOverflow},
+	}
+	for _, tc := range tests {
+		x, m, err := CRT(tc.residues, tc.moduli)
+		if !errors.Is(err, tc.err) || err == nil && (x != tc.x || m != tc.m) {
+			t.Errorf("CRT(%v, %v): want %d, %d, %v, got %d, %d, %v", tc.residues, tc.moduli, tc.x, tc.m, tc.err, x, m, err)
+		}
+	}
+}
+
+func TestInterpolate(t *testing.T) {
+	t.Parallel()
+	// y = x³ - 2x + 1
+	xs := []int{-1, 0, 2, 5}
+	ys := []int{2, 1, 5, 116}
+	if got, err := InterpolateInt(xs, ys, 10); err != nil || got != 981 {
+		t.Errorf("want 981, got %d, %v", got, err)
+	}
+
+	// y = x/2 through two points
+	got, err := Interpolate([]int{0, 2}, []int{0, 1}, 3)
+	if err != nil || got.Cmp(big.NewRat(3, 2)) != 0 {
+		t.Errorf("want 3/2, got %v, %v", got, err)
+	}
+	if _, err := InterpolateInt([]int{0, 2}, []int{0, 1}, 3); !errors.Is(err, ErrNoSolution) {
+		t.Errorf("want %v, got %v", ErrNoSolution, err)
+	}
+	if _, err := Interpolate([]int{1, 1}, []int{0, 1}, 3); !errors.Is(err, errDuplicateX) {
+		t.Errorf("want %v, got %v", errDuplicateX, err)
+	}
+
+	if got, ok := Binomial(62, 31); !ok || got != 465428353255261088 {
+		t.Errorf("want 465428353255261088, got %d", got)
+	}
+	if _, ok := Binomial(100, 50); ok {
+		t.Error("want overflow")
+	}
+}
+
+func TestSolve(t *testing.T) {
+	t.Parallel()
+	// x = 10^20 + 1, 2y + z = 5 and y - 3z = -1, needing a row swap
+	a := IntMatrix(
+		[]int{0, 2, 1},
+		[]int{1, 0, 0},
+		[]int{0, 1, -3},
+	)
+	z, _ := new(big.Rat).SetString("100000000000000000001")
+	b := []*big.Rat{big.NewRat(5, 1), z, big.NewRat(-1, 1)}
+
+	x, err := Solve(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*big.Rat{z, big.NewRat(2, 1), big.NewRat(1, 1)}
+	for i := range want {
+		if x[i].Cmp(want[i]) != 0 {
+			t.Errorf("x[%d]: want %v, got %v", i, want[i], x[i])
+		}
+	}
+
+	_, err = Solve(IntMatrix([]int{1, 2}, []int{2, 4}), IntVector(1, 2))
+	if !errors.Is(err, ErrSingular) {
+		t.Errorf("want %v, got %v", ErrSingular, err)
+	}
+}
+
+func TestPolygon(t *testing.T) {
+	t.Parallel()
+	// a 4x3 rectangle and a right triangle with a slanted edge
+	p := func(row, column int) grid.Point {
+		return grid.Point{Row: row, Column: column}
+	}
+	rectangle := []grid.Point{p(0, 0), p(0, 4), p(3, 4), p(3, 0)}
+	triangle := []grid.Point{p(0, 0), p(0, 4), p(2, 0)}
+
+	tests := []struct {
+		vertices                   []grid.Point
+		doubleArea, boundary, area int
+	}{
+		{rectangle, 24, 14, 6},
+		{triangle, 8, 8, 1},
+	}
+	for _, tc := range tests {
+		if got := DoubleArea(tc.vertices); got != tc.doubleArea {
+			t.Errorf("%v: want double area %d, got %d", tc.vertices, tc.doubleArea, got)
+		}
+		if got := BoundaryPoints(tc.vertices); got != tc.boundary {
+			t.Errorf("%v: want %d boundary points, got %d", tc.vertices, tc.boundary, got)
+		}
+		if got := InteriorPoints(tc.vertices); got != tc.area {
+			t.Errorf("%v: want %d interior points, got %d", tc.vertices, tc.area, got)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package mathx
+
+import "adventofcode23/internal/grid"
+
+// DoubleArea returns twice the area of the simple polygon with the given
+// vertices in order, by the shoelace formula. Doubling keeps the area of
+// lattice polygons integral.
+func DoubleArea(vertices []grid.Point) int {
+	result := 0
+	for i, p := range vertices {
+		q := vertices[(i+1)%len(vertices)]
+		result += p.Column*q.Row - p.Row*q.Column
+	}
+	return Abs(result)
+}
+
+// BoundaryPoints returns the number of lattice points on the edges of the
+// polygon with the given vertices.
+func BoundaryPoints(vertices []grid.Point) int {
+	result := 0
+	for i, p := range vertices {
+		d := vertices[(i+1)%len(vertices)].Sub(p)
+		result += GCD(d.Row, d.Column)
+	}
+	return result
+}
+
+// InteriorPoints returns the number of lattice points strictly inside the
+// simple lattice polygon with the given vertices, by Pick's theorem:
+// A = i + b/2 - 1.
+func InteriorPoints(vertices []grid.Point) int {
+	return (DoubleArea(vertices)-BoundaryPoints(vertices))/2 + 1
+}
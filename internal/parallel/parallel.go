@@ -0,0 +1,81 @@
+// Package parallel spreads independent work over a bounded number of
+// goroutines while keeping results in the order of the input, so that the
+// outcome does not depend on scheduling.
+package parallel
+
+import (
+	"context"
+	"runtime"
+	"sync"
+)
+
+// Workers is the number of goroutines Map uses: one per CPU available to Go.
+func Workers() int {
+	return runtime.GOMAXPROCS(0)
+}
+
+// Ordered calls work(i) for every i in [0, n) on at most workers goroutines.
+// On the calling goroutine, it calls emit(i) in order of i as soon as work(i)
+// and the emits before it are done; emit may be nil. Once ctx is done no more
+// work is started, and Ordered returns the error of ctx after the work in
+// progress has finished.
+func Ordered(ctx context.Context, workers, n int, work func(i int), emit func(i int)) error {
+	done := make([]chan struct{}, n)
+	for i := range done {
+		done[i] = make(chan struct{})
+	}
+
+	next := make(chan int)
+	go func() {
+		defer close(next)
+		for i := 0; i < n; i++ {
+			select {
+			case next <- i:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	for w := 0; w < max(1, min(workers, n)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range next {
+				if ctx.Err() != nil {
+					// leave done[i] open, so that the error is returned
+					continue
+				}
+				work(i)
+				close(done[i])
+			}
+		}()
+	}
+
+	for i := 0; i < n; i++ {
+		select {
+		case <-done[i]:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		if emit != nil {
+			emit(i)
+		}
+	}
+	return nil
+}
+
+// Map returns f applied to every item, using Workers goroutines. The result
+// is in the order of items. It fails with the error of ctx once ctx is done.
+func Map[T, R any](ctx context.Context, items []T, f func(T) R) ([]R, error) {
+	result := make([]R, len(items))
+	err := Ordered(ctx, Workers(), len(items), func(i int) {
+		result[i] = f(items[i])
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
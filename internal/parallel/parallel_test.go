@@ -0,0 +1,86 @@
+package parallel
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMap(t *testing.T) {
+	t.Parallel()
+
+	items := make([]int, 1000)
+	want := make([]int, len(items))
+	for i := range items {
+		items[i] = i
+		want[i] = i * i
+	}
+
+	got, err := Map(context.Background(), items, func(n int) int {
+		return n * n
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(want, got) {
+		t.Errorf("want squares in order, got %v", got)
+	}
+}
+
+func TestOrderedEmitsInOrder(t *testing.T) {
+	t.Parallel()
+
+	n := 50
+	emitted := make([]int, 0, n)
+	err := Ordered(context.Background(), 8, n, func(i int) {
+		// later items finish first
+		time.Sleep(time.Duration(n-i) * 10 * time.Microsecond)
+	}, func(i int) {
+		emitted = append(emitted, i)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, got := range emitted {
+		if i != got {
+			t.Fatalf("want emits in order, got %v", emitted)
+		}
+	}
+	if len(emitted) != n {
+		t.Errorf("want %d emits, got %d", n, len(emitted))
+	}
+}
+
+func TestOrderedLimitsWorkers(t *testing.T) {
+	t.Parallel()
+
+	for _, workers := range []int{1, 3} {
+		var running, peak atomic.Int32
+		err := Ordered(context.Background(), workers, 30, func(int) {
+			r := running.Add(1)
+			for p := peak.Load(); r > p && !peak.CompareAndSwap(p, r); p = peak.Load() {
+			}
+			time.Sleep(100 * time.Microsecond)
+			running.Add(-1)
+		}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := int(peak.Load()); got > workers {
+			t.Errorf("want at most %d workers, got %d", workers, got)
+		}
+	}
+}
+
+func TestCanceled(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := Map(ctx, []int{1, 2, 3}, func(n int) int { return n }); !errors.Is(err, context.Canceled) {
+		t.Errorf("want %v, got %v", context.Canceled, err)
+	}
+}
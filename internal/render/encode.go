@@ -0,0 +1,115 @@
+package render
+
+import (
+	"bufio"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+)
+
+// maxPixels bounds the width and height of PNG images; cells are drawn as
+// squares of as many pixels as fit, but at least one.
+const maxPixels = 2048
+
+var (
+	palette = map[Color]color.RGBA{
+		Plain:     {0xff, 0xff, 0xff, 0xff},
+		Wall:      {0x44, 0x44, 0x44, 0xff},
+		Highlight: {0xff, 0xd7, 0x00, 0xff},
+		Path:      {0xd6, 0x27, 0x28, 0xff},
+		Fill:      {0x1f, 0x77, 0xb4, 0xff},
+		Start:     {0x2c, 0xa0, 0x2c, 0xff},
+	}
+	// text shows plain cells that are not blank
+	text = color.RGBA{0xcc, 0xcc, 0xcc, 0xff}
+
+	// ansiCodes are SGR parameters for the colors
+	ansiCodes = map[Color]string{
+		Plain:     "",
+		Wall:      "90",
+		Highlight: "1;33",
+		Path:      "1;31",
+		Fill:      "34",
+		Start:     "1;32",
+	}
+)
+
+// rgba returns the color of c in images.
+func (c Cell) rgba() color.RGBA {
+	if c.Color == Plain && c.Glyph != ' ' && c.Glyph != '.' {
+		return text
+	}
+	return palette[c.Color]
+}
+
+func (p *Picture) cellSize() int {
+	return max(1, maxPixels/max(p.rows, p.columns, 1))
+}
+
+func (p *Picture) encodePNG(w io.Writer) error {
+	size := p.cellSize()
+	img := image.NewRGBA(image.Rect(0, 0, p.columns*size, p.rows*size))
+	for r := 0; r < p.rows; r++ {
+		for c := 0; c < p.columns; c++ {
+			rgba := p.Get(r, c).rgba()
+			for y := r * size; y < (r+1)*size; y++ {
+				for x := c * size; x < (c+1)*size; x++ {
+					img.SetRGBA(x, y, rgba)
+				}
+			}
+		}
+	}
+	return png.Encode(w, img)
+}
+
+// encodeSVG draws every row as runs of cells of the same color, one unit
+// square per cell.
+func (p *Picture) encodeSVG(w io.Writer) error {
+	b := bufio.NewWriter(w)
+	fmt.Fprintf(b, `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 %d %d" shape-rendering="crispEdges">`+"\n", p.columns, p.rows)
+	fmt.Fprintf(b, `<rect width="%d" height="%d" fill="%s"/>`+"\n", p.columns, p.rows, hex(palette[Plain]))
+	for r := 0; r < p.rows; r++ {
+		for c := 0; c < p.columns; {
+			rgba := p.Get(r, c).rgba()
+			end := c + 1
+			for end < p.columns && p.Get(r, end).rgba() == rgba {
+				end++
+			}
+			if rgba != palette[Plain] {
+				fmt.Fprintf(b, `<rect x="%d" y="%d" width="%d" height="1" fill="%s"/>`+"\n", c, r, end-c, hex(rgba))
+			}
+			c = end
+		}
+	}
+	fmt.Fprintln(b, "</svg>")
+	return b.Flush()
+}
+
+func hex(c color.RGBA) string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
+
+// encodeANSI writes the glyphs of p, switching colors with escape sequences
+// only where they change. Every line ends with the colors reset.
+func (p *Picture) encodeANSI(w io.Writer) error {
+	b := bufio.NewWriter(w)
+	for r := 0; r < p.rows; r++ {
+		current := Plain
+		for c := 0; c < p.columns; c++ {
+			cell := p.Get(r, c)
+			if cell.Color != current {
+				// reset first, so that bold does not carry over
+				fmt.Fprintf(b, "\x1b[0;%sm", ansiCodes[cell.Color])
+				current = cell.Color
+			}
+			b.WriteByte(cell.Glyph)
+		}
+		if current != Plain {
+			b.WriteString("\x1b[0m")
+		}
+		b.WriteByte('\n')
+	}
+	return b.Flush()
+}
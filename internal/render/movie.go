@@ -0,0 +1,167 @@
+package render
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"image/gif"
+	"io"
+	"time"
+)
+
+// MovieFormat is a flag selecting whether and how simulations are animated.
+type MovieFormat string
+
+const (
+	NoMovie  MovieFormat = ""
+	GIF      MovieFormat = "gif"
+	Terminal MovieFormat = "ansi"
+)
+
+func (f *MovieFormat) String() string {
+	return string(*f)
+}
+
+func (f *MovieFormat) Set(s string) error {
+	switch MovieFormat(s) {
+	case GIF, Terminal:
+		*f = MovieFormat(s)
+	default:
+		return fmt.Errorf("unknown animation format %q, want gif or ansi", s)
+	}
+	return nil
+}
+
+const (
+	// maxFrames bounds the frames a movie keeps. Simulations that record
+	// more are sampled evenly.
+	maxFrames = 1000
+	// maxMoviePixels bounds the width and height of GIF frames.
+	maxMoviePixels = 512
+	// hold is how long the last frame of a GIF shows before it loops.
+	hold = 2 * time.Second
+)
+
+// Movie records the frames of an animation. Solvers call Record after every
+// step of a simulation, usually with the same picture changed in between.
+type Movie struct {
+	frames []*Picture
+	every  int // keep every nth recorded picture
+	count  int
+	last   *Picture
+}
+
+func NewMovie() *Movie {
+	return &Movie{every: 1}
+}
+
+// Record adds p as the next frame. Only a copy is kept, so the caller may go
+// on changing p. When a movie grows too long, it drops every other frame
+// and keeps only every other frame from then on.
+func (m *Movie) Record(p *Picture) {
+	m.last = p
+	m.count++
+	if (m.count-1)%m.every != 0 {
+		return
+	}
+
+	m.frames = append(m.frames, p.clone())
+	if len(m.frames) < maxFrames {
+		return
+	}
+	kept := m.frames[:0]
+	for i := 0; i < len(m.frames); i += 2 {
+		kept = append(kept, m.frames[i])
+	}
+	m.frames = kept
+	m.every *= 2
+}
+
+// Frames returns the frames kept, always ending with the last picture
+// recorded.
+func (m *Movie) Frames() []*Picture {
+	if m.last == nil || (m.count-1)%m.every == 0 {
+		return m.frames
+	}
+	return append(m.frames, m.last.clone())
+}
+
+func (p *Picture) clone() *Picture {
+	return &Picture{p.rows, p.columns, append([]Cell(nil), p.cells...)}
+}
+
+// Encode writes the movie to w in format f, showing each frame for delay.
+// Terminal frames are played as they are written, so that Encode only
+// returns once the movie is over or ctx is done.
+func (m *Movie) Encode(ctx context.Context, w io.Writer, f MovieFormat, delay time.Duration) error {
+	switch f {
+	case GIF:
+		return m.encodeGIF(w, delay)
+	case Terminal:
+		return m.play(ctx, w, delay)
+	default:
+		return fmt.Errorf("unknown animation format %q", f)
+	}
+}
+
+func (m *Movie) encodeGIF(w io.Writer, delay time.Duration) error {
+	frames := m.Frames()
+	if len(frames) == 0 {
+		return nil
+	}
+
+	colors := color.Palette{palette[Plain], text}
+	index := map[color.RGBA]uint8{palette[Plain]: 0, text: 1}
+	for c := Wall; c <= Start; c++ {
+		index[palette[c]] = uint8(len(colors))
+		colors = append(colors, palette[c])
+	}
+
+	// in hundredths of a second
+	centiseconds := max(1, int(delay/(10*time.Millisecond)))
+	rows, columns := frames[0].rows, frames[0].columns
+	size := max(1, maxMoviePixels/max(rows, columns, 1))
+	result := &gif.GIF{}
+	for i, f := range frames {
+		img := image.NewPaletted(image.Rect(0, 0, columns*size, rows*size), colors)
+		for r := 0; r < f.rows; r++ {
+			for c := 0; c < f.columns; c++ {
+				ci := index[f.Get(r, c).rgba()]
+				for y := r * size; y < (r+1)*size; y++ {
+					for x := c * size; x < (c+1)*size; x++ {
+						img.SetColorIndex(x, y, ci)
+					}
+				}
+			}
+		}
+		result.Image = append(result.Image, img)
+		if i == len(frames)-1 {
+			result.Delay = append(result.Delay, max(centiseconds, int(hold/(10*time.Millisecond))))
+		} else {
+			result.Delay = append(result.Delay, centiseconds)
+		}
+	}
+	return gif.EncodeAll(w, result)
+}
+
+// play draws the frames one after the other at the top of a cleared
+// terminal.
+func (m *Movie) play(ctx context.Context, w io.Writer, delay time.Duration) error {
+	ticker := time.NewTicker(max(delay, time.Millisecond))
+	defer ticker.Stop()
+	for _, f := range m.Frames() {
+		if _, err := io.WriteString(w, "\x1b[H\x1b[2J"); err != nil {
+			return err
+		}
+		if err := f.encodeANSI(w); err != nil {
+			return err
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package render
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image/gif"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMovieSampling(t *testing.T) {
+	t.Parallel()
+	m := NewMovie()
+	p := New(1, 1)
+	n := 3*maxFrames + 7
+	for i := 0; i < n; i++ {
+		p.Set(0, 0, Cell{Glyph: byte(i % 256)})
+		m.Record(p)
+	}
+
+	frames := m.Frames()
+	if len(frames) >= maxFrames || len(frames) < maxFrames/4 {
+		t.Errorf("want at most %d frames, got %d", maxFrames, len(frames))
+	}
+	if got := frames[0].Get(0, 0).Glyph; got != 0 {
+		t.Errorf("want the first frame kept, got glyph %d", got)
+	}
+	if got, want := frames[len(frames)-1].Get(0, 0).Glyph, byte((n-1)%256); got != want {
+		t.Errorf("want the last frame kept with glyph %d, got %d", want, got)
+	}
+}
+
+func TestGIF(t *testing.T) {
+	t.Parallel()
+	m := NewMovie()
+	p := picture()
+	m.Record(p)
+	p.Paint(1, 0, Path)
+	m.Record(p)
+
+	var b bytes.Buffer
+	if err := m.Encode(context.Background(), &b, GIF, 50*time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	g, err := gif.DecodeAll(&b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(g.Image) != 2 || g.Delay[0] != 5 {
+		t.Errorf("want 2 frames of 5/100 s, got %d frames with delays %v", len(g.Image), g.Delay)
+	}
+}
+
+func TestPlay(t *testing.T) {
+	t.Parallel()
+	m := NewMovie()
+	p := picture()
+	m.Record(p)
+	m.Record(p)
+
+	var b bytes.Buffer
+	if err := m.Encode(context.Background(), &b, Terminal, time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Count(b.String(), "\x1b[2J"); got != 2 {
+		t.Errorf("want 2 frames, got %d", got)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := m.Encode(ctx, &b, Terminal, time.Hour); !errors.Is(err, context.Canceled) {
+		t.Errorf("want %v, got %v", context.Canceled, err)
+	}
+}
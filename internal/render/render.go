@@ -0,0 +1,145 @@
+// Package render draws what the solvers compute, such as a loop of pipes or
+// a path through a map, as PNG or SVG images or as colored text for ANSI
+// terminals. It only depends on the standard library.
+package render
+
+import (
+	"fmt"
+	"io"
+)
+
+// Format is a flag selecting whether and how pictures are rendered.
+type Format string
+
+const (
+	NoRender Format = ""
+	PNG      Format = "png"
+	SVG      Format = "svg"
+	ANSI     Format = "ansi"
+)
+
+func (f *Format) String() string {
+	return string(*f)
+}
+
+func (f *Format) Set(s string) error {
+	switch Format(s) {
+	case PNG, SVG, ANSI:
+		*f = Format(s)
+	default:
+		return fmt.Errorf("unknown render format %q, want png, svg or ansi", s)
+	}
+	return nil
+}
+
+// Ext returns the file name extension of pictures in f, including the dot.
+func (f Format) Ext() string {
+	if f == ANSI {
+		return ".txt"
+	}
+	return "." + string(f)
+}
+
+// Color is one of a small palette, chosen to tell apart what a solver found
+// from the map it worked on. The zero Color leaves a cell plain.
+type Color uint8
+
+const (
+	Plain     Color = iota
+	Wall            // obstacles: rocks, forest
+	Highlight       // cells the solver visited: energized tiles, reached plots
+	Path            // the path or loop the answer is about
+	Fill            // area enclosed by a path
+	Start           // where the solver started
+)
+
+// Cell is a single cell of a picture: a character for text output and a
+// color for all formats.
+type Cell struct {
+	Glyph byte
+	Color Color
+}
+
+// Picture is a rectangle of cells.
+type Picture struct {
+	rows, columns int
+	cells         []Cell
+}
+
+// New returns a picture of blank, plain cells.
+func New(rows, columns int) *Picture {
+	cells := make([]Cell, rows*columns)
+	for i := range cells {
+		cells[i].Glyph = ' '
+	}
+	return &Picture{rows, columns, cells}
+}
+
+// FromText returns a picture of plain cells showing the given lines, which
+// must all have the same length.
+func FromText(lines [][]byte) *Picture {
+	if len(lines) == 0 {
+		return New(0, 0)
+	}
+	result := New(len(lines), len(lines[0]))
+	for r, line := range lines {
+		result.SetText(r, 0, line)
+	}
+	return result
+}
+
+func (p *Picture) Rows() int {
+	return p.rows
+}
+
+func (p *Picture) Columns() int {
+	return p.columns
+}
+
+// In reports whether row and column lie within p.
+func (p *Picture) In(row, column int) bool {
+	return row >= 0 && row < p.rows && column >= 0 && column < p.columns
+}
+
+func (p *Picture) index(row, column int) int {
+	if !p.In(row, column) {
+		panic(fmt.Sprintf("render: cell %d, %d outside %dx%d picture", row, column, p.rows, p.columns))
+	}
+	return row*p.columns + column
+}
+
+// Get returns the cell at row and column. It panics when they lie outside p.
+func (p *Picture) Get(row, column int) Cell {
+	return p.cells[p.index(row, column)]
+}
+
+// Set replaces the cell at row and column. It panics when they lie outside p.
+func (p *Picture) Set(row, column int, c Cell) {
+	p.cells[p.index(row, column)] = c
+}
+
+// Paint colors the cell at row and column, keeping its glyph.
+func (p *Picture) Paint(row, column int, c Color) {
+	p.cells[p.index(row, column)].Color = c
+}
+
+// SetText writes text into row from column on, keeping the colors.
+func (p *Picture) SetText(row, column int, text []byte) {
+	for i, ch := range text {
+		p.cells[p.index(row, column+i)].Glyph = ch
+	}
+}
+
+// Encode writes p to w in format f.
+func (p *Picture) Encode(w io.Writer, f Format) error {
+	switch f {
+	case PNG:
+		return p.encodePNG(w)
+	case SVG:
+		return p.encodeSVG(w)
+	case ANSI:
+		return p.encodeANSI(w)
+	default:
+		return fmt.Errorf("unknown render format %q", f)
+	}
+}
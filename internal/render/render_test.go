@@ -0,0 +1,106 @@
+package render
+
+import (
+	"bytes"
+	"encoding/xml"
+	"image/png"
+	"io"
+	"strings"
+	"testing"
+)
+
+func picture() *Picture {
+	p := FromText([][]byte{
+		[]byte("S.#"),
+		[]byte("..#"),
+	})
+	p.Paint(0, 0, Start)
+	p.Paint(0, 1, Path)
+	p.Paint(1, 1, Path)
+	return p
+}
+
+func TestPNG(t *testing.T) {
+	t.Parallel()
+	p := picture()
+	var b bytes.Buffer
+	if err := p.Encode(&b, PNG); err != nil {
+		t.Fatal(err)
+	}
+	img, err := png.Decode(&b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	size := p.cellSize()
+	if got := img.Bounds().Size(); got.X != 3*size || got.Y != 2*size {
+		t.Fatalf("want %dx%d pixels, got %v", 3*size, 2*size, got)
+	}
+	tests := []struct {
+		row, column int
+		want        Cell
+	}{
+		{0, 0, Cell{'S', Start}},
+		{0, 2, Cell{'#', Plain}},
+		{1, 0, Cell{'.', Plain}},
+		{1, 1, Cell{'.', Path}},
+	}
+	for _, tc := range tests {
+		r, g, b, _ := img.At(tc.column*size+size/2, tc.row*size+size/2).RGBA()
+		want := tc.want.rgba()
+		if uint8(r>>8) != want.R || uint8(g>>8) != want.G || uint8(b>>8) != want.B {
+			t.Errorf("cell %d, %d: want %v, got %d, %d, %d", tc.row, tc.column, want, r>>8, g>>8, b>>8)
+		}
+	}
+}
+
+func TestSVG(t *testing.T) {
+	t.Parallel()
+	var b bytes.Buffer
+	if err := picture().Encode(&b, SVG); err != nil {
+		t.Fatal(err)
+	}
+
+	rects := 0
+	d := xml.NewDecoder(&b)
+	for {
+		token, err := d.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if e, ok := token.(xml.StartElement); ok && e.Name.Local == "rect" {
+			rects++
+		}
+	}
+	// background, start, path and wall in the first row, path and wall in
+	// the second
+	if rects != 6 {
+		t.Errorf("want 6 rectangles, got %d", rects)
+	}
+}
+
+func TestANSI(t *testing.T) {
+	t.Parallel()
+	var b bytes.Buffer
+	if err := picture().Encode(&b, ANSI); err != nil {
+		t.Fatal(err)
+	}
+	want := "\x1b[0;1;32mS\x1b[0;1;31m.\x1b[0;m#\n.\x1b[0;1;31m.\x1b[0;m#\n"
+	if got := b.String(); got != want {
+		t.Errorf("want %q, got %q", want, got)
+	}
+}
+
+func TestFormat(t *testing.T) {
+	t.Parallel()
+	var f Format
+	if err := f.Set("svg"); err != nil || f != SVG || f.Ext() != ".svg" {
+		t.Errorf("want %q, got %q, %v", SVG, f, err)
+	}
+	if err := f.Set("gif"); err == nil || !strings.Contains(err.Error(), "gif") {
+		t.Errorf("want error for gif, got %v", err)
+	}
+}
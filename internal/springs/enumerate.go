@@ -0,0 +1,110 @@
+package springs
+
+// Enumerator lists the arrangements of a row one at a time, with the groups
+// as far left as they go first. It only follows choices that lead to an
+// arrangement, so every call of Next takes time in proportion to the length
+// of the record.
+type Enumerator struct {
+	r        Row
+	runs     []int
+	possible [][]bool // possible[g][i]: groups[g:] can be arranged from i on
+	limit    int
+	listed   int
+
+	arrangement []byte
+	branches    []branch
+	started     bool
+}
+
+// branch is a choice where group was started at position i but the spring
+// could have been operational.
+type branch struct {
+	i, group int
+}
+
+// Arrangements returns an Enumerator of the arrangements of r, which stops
+// after limit arrangements unless limit is 0.
+func (r Row) Arrangements(limit int) *Enumerator {
+	n, groups := len(r.Record), len(r.Groups)
+	runs := r.runs()
+	possible := make([][]bool, groups+1)
+	for g := groups; g >= 0; g-- {
+		possible[g] = make([]bool, n+1)
+		possible[g][n] = g == groups
+		for i := n - 1; i >= 0; i-- {
+			possible[g][i] = r.Record[i] != Damaged && possible[g][i+1]
+			if g == groups {
+				continue
+			}
+			if next, ok := r.fits(runs, i, r.Groups[g]); ok && possible[g+1][next] {
+				possible[g][i] = true
+			}
+		}
+	}
+	return &Enumerator{
+		r:           r,
+		runs:        runs,
+		possible:    possible,
+		limit:       limit,
+		arrangement: []byte(r.Record),
+	}
+}
+
+// place makes the group start at i, and returns where the next one can.
+func (e *Enumerator) place(i, group int) int {
+	size := e.r.Groups[group]
+	for k := i; k < i+size; k++ {
+		e.arrangement[k] = Damaged
+	}
+	next, _ := e.r.fits(e.runs, i, size)
+	if next > i+size {
+		e.arrangement[i+size] = Operational
+	}
+	return next
+}
+
+// complete fills in the springs from position i on, starting with group.
+// It starts groups as early as it can, and remembers where a spring could
+// have been operational instead.
+func (e *Enumerator) complete(i, group int) {
+	for n := len(e.arrangement); i < n; {
+		canSkip := e.r.Record[i] != Damaged && e.possible[group][i+1]
+		if group < len(e.r.Groups) {
+			if next, ok := e.r.fits(e.runs, i, e.r.Groups[group]); ok && e.possible[group+1][next] {
+				if canSkip {
+					e.branches = append(e.branches, branch{i, group})
+				}
+				i = e.place(i, group)
+				group++
+				continue
+			}
+		}
+		e.arrangement[i] = Operational
+		i++
+	}
+}
+
+// Next returns the next arrangement, or false when there are no more or the
+// limit is reached.
+func (e *Enumerator) Next() (string, bool) {
+	if e.limit > 0 && e.listed == e.limit {
+		return "", false
+	}
+	switch {
+	case !e.started:
+		e.started = true
+		if !e.possible[0][0] {
+			return "", false
+		}
+		e.complete(0, 0)
+	case len(e.branches) == 0:
+		return "", false
+	default:
+		b := e.branches[len(e.branches)-1]
+		e.branches = e.branches[:len(e.branches)-1]
+		e.arrangement[b.i] = Operational
+		e.complete(b.i+1, b.group)
+	}
+	e.listed++
+	return string(e.arrangement), true
+}
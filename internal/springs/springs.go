@@ -0,0 +1,125 @@
+// Package springs counts and lists the arrangements of the condition records
+// of day 12. A record shows springs that are operational (.), damaged (#) or
+// unknown (?), together with the sizes of the groups of damaged springs in
+// order; an arrangement replaces every unknown spring so that the groups
+// match. Counts are exact however large unfolding makes them.
+package springs
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"adventofcode23/internal/day"
+)
+
+const (
+	Operational = '.'
+	Damaged     = '#'
+	Unknown     = '?'
+)
+
+// Row is a condition record.
+type Row struct {
+	Record string
+	Groups []int
+}
+
+// Parse parses a row such as "???.### 1,1,3". Errors are *day.ParseError
+// with the column.
+func Parse(line string) (Row, error) {
+	record, g, ok := strings.Cut(line, " ")
+	if !ok {
+		return Row{}, day.Errorf(1, "missing group sizes")
+	}
+	if i := strings.IndexFunc(record, func(r rune) bool { return !strings.ContainsRune(".#?", r) }); i != -1 {
+		return Row{}, day.Errorf(i+1, "invalid spring %q", record[i])
+	}
+	groups, err := day.SplitInts(g, ",", len(record)+2)
+	if err != nil {
+		return Row{}, err
+	}
+	for _, size := range groups {
+		if size < 1 {
+			return Row{}, day.Errorf(len(record)+2, "invalid group size %d", size)
+		}
+	}
+	return Row{record, groups}, nil
+}
+
+func (r Row) String() string {
+	groups := make([]string, len(r.Groups))
+	for i, size := range r.Groups {
+		groups[i] = fmt.Sprint(size)
+	}
+	return r.Record + " " + strings.Join(groups, ",")
+}
+
+// Unfold returns factor copies of r: the records joined by unknown springs,
+// and the groups repeated. Unfold panics when factor is less than 1.
+func (r Row) Unfold(factor int) Row {
+	if factor < 1 {
+		panic(fmt.Sprintf("springs: unfold factor %d", factor))
+	}
+	records := make([]string, factor)
+	groups := make([]int, 0, factor*len(r.Groups))
+	for i := range records {
+		records[i] = r.Record
+		groups = append(groups, r.Groups...)
+	}
+	return Row{strings.Join(records, string(Unknown)), groups}
+}
+
+// runs returns the number of springs that can be damaged from every
+// position of the record on, up to the next operational one.
+func (r Row) runs() []int {
+	result := make([]int, len(r.Record)+1)
+	for i := len(r.Record) - 1; i >= 0; i-- {
+		if r.Record[i] != Operational {
+			result[i] = result[i+1] + 1
+		}
+	}
+	return result
+}
+
+// fits reports whether a group of size can start at position i, with runs
+// from r.runs: the springs there can be damaged, and the one after the
+// group, if any, can be operational. It returns where the rest of the
+// groups can start.
+func (r Row) fits(runs []int, i, size int) (int, bool) {
+	end := i + size
+	if runs[i] < size || end < len(r.Record) && r.Record[end] == Damaged {
+		return 0, false
+	}
+	return min(end+1, len(r.Record)), true
+}
+
+// Count returns the number of arrangements of r.
+func (r Row) Count() *big.Int {
+	n := len(r.Record)
+	runs := r.runs()
+
+	// later[i] is the number of ways to arrange the groups after the
+	// current one from position i on; ways[i] includes the current group
+	later, ways := make([]big.Int, n+1), make([]big.Int, n+1)
+	later[n].SetInt64(1)
+	for i := n - 1; i >= 0 && r.Record[i] != Damaged; i-- {
+		later[i].SetInt64(1)
+	}
+	for g := len(r.Groups) - 1; g >= 0; g-- {
+		ways[n].SetInt64(0)
+		for i := n - 1; i >= 0; i-- {
+			w := &ways[i]
+			if r.Record[i] != Damaged {
+				w.Set(&ways[i+1])
+			} else {
+				w.SetInt64(0)
+			}
+			if next, ok := r.fits(runs, i, r.Groups[g]); ok {
+				w.Add(w, &later[next])
+			}
+		}
+		later, ways = ways, later
+	}
+	return new(big.Int).Set(&later[0])
+}
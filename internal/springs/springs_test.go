@@ -0,0 +1,169 @@
+package springs
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+	"testing"
+
+	"adventofcode23/internal/day"
+)
+
+func parse(t *testing.T, line string) Row {
+	t.Helper()
+	r, err := Parse(line)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+func TestCount(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		line           string
+		want, unfolded int64
+	}{
+		{"???.### 1,1,3", 1, 1},
+		{".??..??...?##. 1,1,3", 4, 16384},
+		{"?#?#?#?#?#?#?#? 1,3,1,6", 1, 1},
+		{"????.#...#... 4,1,1", 1, 16},
+		{"????.######..#####. 1,6,5", 4, 2500},
+		{"?###???????? 3,2,1", 10, 506250},
+		{"# 2", 0, 0},
+		{"... 1", 0, 0},
+	}
+
+	for _, test := range tests {
+		r := parse(t, test.line)
+		if got := r.Count(); got.Cmp(big.NewInt(test.want)) != 0 {
+			t.Errorf("%s: want %d, got %v", test.line, test.want, got)
+		}
+		if got := r.Unfold(5).Count(); got.Cmp(big.NewInt(test.unfolded)) != 0 {
+			t.Errorf("%s unfolded: want %d, got %v", test.line, test.unfolded, got)
+		}
+	}
+}
+
+func TestCountBig(t *testing.T) {
+	t.Parallel()
+	// unfolding ??? 1 f times gives 4f-1 unknown springs with f groups of
+	// one, which can be arranged in binomial(3f, f) ways
+	const f = 40
+	got := parse(t, "??? 1").Unfold(f).Count()
+	if want := new(big.Int).Binomial(3*f, f); got.Cmp(want) != 0 {
+		t.Errorf("want %v, got %v", want, got)
+	}
+	if got.IsInt64() {
+		t.Errorf("want more than an int64 holds, got %v", got)
+	}
+}
+
+func TestUnfold(t *testing.T) {
+	t.Parallel()
+	want := "???.###????.###????.### 1,1,3,1,1,3,1,1,3"
+	if got := parse(t, "???.### 1,1,3").Unfold(3).String(); got != want {
+		t.Errorf("want %q, got %q", want, got)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		line   string
+		column int
+	}{
+		{"???", 1},
+		{"?x? 1", 2},
+		{"??? 1,a", 7},
+		{"??? 1,0", 5},
+	}
+	for _, test := range tests {
+		_, err := Parse(test.line)
+		var pe *day.ParseError
+		if !errors.As(err, &pe) || pe.Column != test.column {
+			t.Errorf("%q: want an error in column %d, got %v", test.line, test.column, err)
+		}
+	}
+}
+
+func all(e *Enumerator) []string {
+	var result []string
+	for a, ok := e.Next(); ok; a, ok = e.Next() {
+		result = append(result, a)
+	}
+	return result
+}
+
+func TestArrangements(t *testing.T) {
+	t.Parallel()
+	r := parse(t, "?###???????? 3,2,1")
+
+	want := []string{
+		".###.##.#...",
+		".###.##..#..",
+		".###.##...#.",
+		".###.##....#",
+		".###..##.#..",
+		".###..##..#.",
+		".###..##...#",
+		".###...##.#.",
+		".###...##..#",
+		".###....##.#",
+	}
+	if got := all(r.Arrangements(0)); !slices.Equal(want, got) {
+		t.Errorf("want %q, got %q", want, got)
+	}
+	if got := all(r.Arrangements(3)); !slices.Equal(want[:3], got) {
+		t.Errorf("limit 3: want %q, got %q", want[:3], got)
+	}
+	if got := all(parse(t, "# 2").Arrangements(0)); len(got) != 0 {
+		t.Errorf("want no arrangements, got %q", got)
+	}
+}
+
+// matches reports whether arrangement fills in the unknown springs of r.
+func matches(r Row, arrangement string) bool {
+	if len(arrangement) != len(r.Record) || strings.Contains(arrangement, string(Unknown)) {
+		return false
+	}
+	for i := range arrangement {
+		if r.Record[i] != Unknown && r.Record[i] != arrangement[i] {
+			return false
+		}
+	}
+	var groups []int
+	for _, g := range strings.FieldsFunc(arrangement, func(r rune) bool { return r == Operational }) {
+		groups = append(groups, len(g))
+	}
+	return slices.Equal(r.Groups, groups)
+}
+
+func TestArrangementsCount(t *testing.T) {
+	t.Parallel()
+
+	for _, line := range []string{
+		".??..??...?##. 1,1,3",
+		"????.######..#####. 1,6,5",
+		"?#?#?#?#?#?#?#? 1,3,1,6",
+		"?????????? 1,2,1",
+		"??#??.??#? 2,1,1",
+	} {
+		r := parse(t, line).Unfold(2)
+		got := all(r.Arrangements(0))
+		if want := r.Count(); fmt.Sprint(len(got)) != want.String() {
+			t.Errorf("%s: want %v arrangements, got %d", r, want, len(got))
+		}
+		seen := make(map[string]bool, len(got))
+		for _, a := range got {
+			if seen[a] || !matches(r, a) {
+				t.Errorf("%s: want distinct matching arrangements, got %q twice or not matching", r, a)
+			}
+			seen[a] = true
+		}
+	}
+}
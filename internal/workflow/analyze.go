@@ -0,0 +1,313 @@
+package workflow
+
+import (
+	"fmt"
+	"strings"
+
+	"adventofcode23/internal/graph"
+	"adventofcode23/internal/interval"
+	"adventofcode23/internal/mathx"
+)
+
+// Box is a range of ratings per category, which makes a hyper-rectangle of
+// parts.
+type Box map[string]interval.Interval
+
+// Bounds returns the box of the ratings from lo to hi in every category.
+func Bounds(categories []string, lo, hi int) Box {
+	result := make(Box, len(categories))
+	for _, c := range categories {
+		result[c] = interval.Closed(lo, hi)
+	}
+	return result
+}
+
+// Volume returns the number of parts in b, or false when it overflows.
+func (b Box) Volume() (int, bool) {
+	result := 1
+	for _, i := range b {
+		var ok bool
+		if result, ok = mathx.Mul(result, i.Len()); !ok {
+			return 0, false
+		}
+	}
+	return result, true
+}
+
+// Empty reports whether b holds no parts.
+func (b Box) Empty() bool {
+	for _, i := range b {
+		if i.Empty() {
+			return true
+		}
+	}
+	return false
+}
+
+// Contains reports whether p has a rating within b in every category of b.
+func (b Box) Contains(p Part) bool {
+	for category, i := range b {
+		rating, ok := p[category]
+		if !ok || !i.Contains(rating) {
+			return false
+		}
+	}
+	return true
+}
+
+func (b Box) clone() Box {
+	result := make(Box, len(b))
+	for k, v := range b {
+		result[k] = v
+	}
+	return result
+}
+
+// split divides b into the parts that match c and those that do not.
+func (b Box) split(c Condition) (match, miss Box, err error) {
+	i, ok := b[c.Category]
+	if !ok {
+		return nil, nil, fmt.Errorf("%w %s in %v", ErrMissingRating, c.Category, b)
+	}
+	matching := interval.Interval{Start: c.Value + 1, End: i.End}
+	missing := interval.Interval{Start: i.Start, End: c.Value + 1}
+	if c.Op == Less {
+		matching = interval.Interval{Start: i.Start, End: c.Value}
+		missing = interval.Interval{Start: c.Value, End: i.End}
+	}
+	match, miss = b.clone(), b.clone()
+	match[c.Category], miss[c.Category] = i.Intersect(matching), i.Intersect(missing)
+	return match, miss, nil
+}
+
+// String returns b like a part with closed ranges, such as
+// {a=1..2005,m=2091..4000}.
+func (b Box) String() string {
+	ranges := make([]string, 0, len(b))
+	for _, category := range sortedKeys(b) {
+		i := b[category]
+		ranges = append(ranges, fmt.Sprintf("%s=%d..%d", category, i.Start, i.End-1))
+	}
+	return "{" + strings.Join(ranges, ",") + "}"
+}
+
+// Walk sends the ratings within bounds through the workflows from Start,
+// splitting them at every condition. It calls visit for every rule that
+// receives ratings, with the rules followed to get there, ending with that
+// rule, and the box of ratings the rule sends on. visit must not keep path,
+// which is reused. Walk returns an error wrapping ErrCycle when ratings can
+// come back to a workflow, and one wrapping ErrMissingRating when a
+// condition is on a category outside bounds.
+func (s *System) Walk(bounds Box, visit func(path []Ref, box Box)) error {
+	var path []Ref
+	onPath := make(map[string]bool)
+
+	var walk func(name string, box Box) error
+	walk = func(name string, box Box) error {
+		if onPath[name] {
+			return fmt.Errorf("%w: %s -> %s", ErrCycle, routeString(path), name)
+		}
+		onPath[name] = true
+		defer delete(onPath, name)
+
+		for i, r := range s.Workflows[name].Rules {
+			match := box
+			if r.Condition != nil {
+				var err error
+				if match, box, err = box.split(*r.Condition); err != nil {
+					return fmt.Errorf("%w at %v", err, r.Pos)
+				}
+			}
+			if !match.Empty() {
+				path = append(path, Ref{name, i})
+				visit(path, match)
+				if _, ok := s.Workflows[r.Target]; ok {
+					if err := walk(r.Target, match); err != nil {
+						return err
+					}
+				}
+				path = path[:len(path)-1]
+			}
+			if r.Condition == nil || box.Empty() {
+				break
+			}
+		}
+		return nil
+	}
+	return walk(Start, bounds.clone())
+}
+
+// Path is a way through the workflows to Accept, with the exact box of
+// ratings that takes it.
+type Path struct {
+	Rules []Ref
+	Box   Box
+}
+
+// String returns the workflows along p and its box, such as
+// in -> px -> A {a=1..2005,m=2091..4000,s=1..1350,x=1..4000}.
+func (p Path) String() string {
+	return fmt.Sprintf("%s -> %s %v", routeString(p.Rules), Accept, p.Box)
+}
+
+// Accepted returns the ways through the workflows that accept ratings within
+// bounds, with the boxes of ratings taking them. The boxes are disjoint and
+// hold every accepted part within bounds.
+func (s *System) Accepted(bounds Box) ([]Path, error) {
+	var result []Path
+	err := s.Walk(bounds, func(path []Ref, box Box) {
+		if s.Rule(path[len(path)-1]).Target == Accept {
+			result = append(result, Path{append([]Ref(nil), path...), box})
+		}
+	})
+	return result, err
+}
+
+// Count returns the number of parts within bounds that are accepted. It
+// returns mathx.ErrOverflow when they are too many for an int.
+func (s *System) Count(bounds Box) (int, error) {
+	result, fits := 0, true
+	err := s.Walk(bounds, func(path []Ref, box Box) {
+		if s.Rule(path[len(path)-1]).Target != Accept || !fits {
+			return
+		}
+		var volume int
+		if volume, fits = box.Volume(); fits {
+			result, fits = mathx.Add(result, volume)
+		}
+	})
+	if err == nil && !fits {
+		err = mathx.ErrOverflow
+	}
+	return result, err
+}
+
+// Cycle returns workflows that send parts on in a circle, starting and ending
+// with the same workflow, or false when there are none. Unlike Walk, Cycle
+// ignores conditions, so parts may never actually go round.
+func (s *System) Cycle() ([]string, bool) {
+	g := graph.NewDirected[string]()
+	for _, name := range s.Names {
+		g.AddNode(name)
+	}
+	for _, name := range s.Names {
+		for _, r := range s.Workflows[name].Rules {
+			if _, ok := s.Workflows[r.Target]; ok {
+				g.AddEdge(name, r.Target, 1)
+			}
+		}
+	}
+	return graph.FindCycle(g)
+}
+
+// Analysis is what the symbolic analysis of a System finds.
+type Analysis struct {
+	// Dead holds the rules that no ratings reach, in input order.
+	Dead []Ref
+	// Unreachable holds the workflows that no ratings reach, in input
+	// order.
+	Unreachable []string
+}
+
+// Analyze finds the rules and workflows that no ratings within bounds reach.
+// These include rules after a rule without condition, rules whose condition
+// earlier rules already decide, and everything only reached through them. It
+// returns the errors of Walk.
+func (s *System) Analyze(bounds Box) (Analysis, error) {
+	rules := make(map[Ref]bool)
+	workflows := map[string]bool{Start: !bounds.Empty()}
+	err := s.Walk(bounds, func(path []Ref, box Box) {
+		last := path[len(path)-1]
+		rules[last] = true
+		workflows[s.Rule(last).Target] = true
+	})
+	if err != nil {
+		return Analysis{}, err
+	}
+
+	var result Analysis
+	for _, name := range s.Names {
+		if !workflows[name] {
+			result.Unreachable = append(result.Unreachable, name)
+		}
+		for i := range s.Workflows[name].Rules {
+			if !rules[Ref{name, i}] {
+				result.Dead = append(result.Dead, Ref{name, i})
+			}
+		}
+	}
+	return result, nil
+}
+
+// Eliminate returns a copy of s that sorts every part within bounds as s
+// does, with less to it. It drops the rules and workflows that Analyze
+// finds no ratings reach, and drops the condition of a rule that all
+// ratings reaching it match. A rule followed by the last rule of its
+// workflow is dropped when both have the same target, and a workflow left
+// with a single rule is replaced by its target wherever it is used. Parts
+// outside bounds may be sorted differently. Positions and parts are kept.
+func (s *System) Eliminate(bounds Box) (*System, error) {
+	a, err := s.Analyze(bounds)
+	if err != nil {
+		return nil, err
+	}
+	dead := make(map[Ref]bool, len(a.Dead))
+	for _, r := range a.Dead {
+		dead[r] = true
+	}
+
+	workflows := make(map[string]*Workflow, len(s.Workflows))
+	for _, name := range s.Names {
+		w := s.Workflows[name]
+		var rules []Rule
+		for i, r := range w.Rules {
+			if !dead[Ref{name, i}] {
+				rules = append(rules, r)
+			}
+		}
+		if len(rules) == 0 && name == Start {
+			// no ratings at all
+			rules = []Rule{{Target: Reject, Pos: w.Pos}}
+		}
+		if len(rules) == 0 {
+			continue
+		}
+		// the rules after it are dead, so whatever reaches it matches
+		rules[len(rules)-1].Condition = nil
+		workflows[name] = &Workflow{Name: name, Rules: rules, Pos: w.Pos}
+	}
+
+	for changed := true; changed; {
+		changed = false
+		for _, name := range s.Names {
+			w, ok := workflows[name]
+			if !ok {
+				continue
+			}
+			for n := len(w.Rules); n >= 2 && w.Rules[n-2].Target == w.Rules[n-1].Target; n-- {
+				w.Rules = append(w.Rules[:n-2], w.Rules[n-1])
+				changed = true
+			}
+			if len(w.Rules) > 1 || name == Start {
+				continue
+			}
+			delete(workflows, name)
+			for _, other := range workflows {
+				for i := range other.Rules {
+					if other.Rules[i].Target == name {
+						other.Rules[i].Target = w.Rules[0].Target
+					}
+				}
+			}
+			changed = true
+		}
+	}
+
+	result := &System{Workflows: workflows, Parts: s.Parts}
+	for _, name := range s.Names {
+		if _, ok := workflows[name]; ok {
+			result.Names = append(result.Names, name)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,201 @@
+package workflow
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"adventofcode23/internal/mathx"
+)
+
+func xmas() Box {
+	return Bounds([]string{"x", "m", "a", "s"}, 1, 4000)
+}
+
+func TestCount(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s      *System
+		bounds Box
+		want   int
+	}{
+		{example(t), xmas(), 167409079868000},
+		{parse(t, "in{speed>10:A,weight<3:A,R}"), Bounds([]string{"speed", "weight"}, 1, 20), 220},
+		// the cycle is never taken
+		{parse(t, "in{x<5:p,A}", "p{x>10:in,A}"), Bounds([]string{"x"}, 1, 10), 10},
+	}
+
+	for _, test := range tests {
+		got, err := test.s.Count(test.bounds)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.want {
+			t.Errorf("%s: want %d, got %d", test.s.Names, test.want, got)
+		}
+	}
+}
+
+func TestAccepted(t *testing.T) {
+	t.Parallel()
+	s := example(t)
+
+	paths, err := s.Accepted(xmas())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "in -> px -> qkq -> A {a=1..2005,m=1..4000,s=1..1350,x=1..1415}"
+	if len(paths) != 9 || paths[0].String() != want {
+		t.Fatalf("want 9 paths starting with %s, got %v", want, paths)
+	}
+
+	volume := 0
+	for i, p := range paths {
+		v, _ := p.Box.Volume()
+		volume += v
+		// the lowest and highest corners of every box are accepted, and in
+		// no other box
+		low, high := make(Part), make(Part)
+		for category, r := range p.Box {
+			low[category], high[category] = r.Start, r.End-1
+		}
+		for _, part := range []Part{low, high} {
+			if ok, err := s.Accepts(part); !ok || err != nil {
+				t.Errorf("%v: want %v accepted, got %v, %v", p, part, ok, err)
+			}
+			for j, other := range paths {
+				if j != i && other.Box.Contains(part) {
+					t.Errorf("%v: want %v in no other box, got %v", p, part, other)
+				}
+			}
+		}
+	}
+	if volume != 167409079868000 {
+		t.Errorf("want boxes of 167409079868000 parts, got %d", volume)
+	}
+}
+
+func TestAnalyze(t *testing.T) {
+	t.Parallel()
+	s := parse(t, "in{x<10:a,x<5:R,b}", "a{x>20:R,A}", "b{A}", "c{R}")
+
+	a, err := s.Analyze(Bounds([]string{"x"}, 1, 4000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []Ref{{"in", 1}, {"a", 0}, {"c", 0}}; !slices.Equal(want, a.Dead) {
+		t.Errorf("dead rules: want %v, got %v", want, a.Dead)
+	}
+	if want := []string{"c"}; !slices.Equal(want, a.Unreachable) {
+		t.Errorf("unreachable workflows: want %v, got %v", want, a.Unreachable)
+	}
+
+	if a, err := example(t).Analyze(xmas()); err != nil || len(a.Dead) > 0 || len(a.Unreachable) > 0 {
+		t.Errorf("example: want nothing dead, got %+v, %v", a, err)
+	}
+}
+
+func TestCycles(t *testing.T) {
+	t.Parallel()
+
+	// the cycle is never taken
+	s := parse(t, "in{x<5:p,A}", "p{x>10:in,A}")
+	if got, ok := s.Cycle(); !ok || !slices.Equal([]string{"in", "p", "in"}, got) {
+		t.Errorf("want cycle in p in, got %v, %v", got, ok)
+	}
+	a, err := s.Analyze(Bounds([]string{"x"}, 1, 10))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []Ref{{"p", 0}}; !slices.Equal(want, a.Dead) {
+		t.Errorf("dead rules: want %v, got %v", want, a.Dead)
+	}
+
+	s = parse(t, "in{x<5:p,A}", "p{in}")
+	if _, err := s.Analyze(Bounds([]string{"x"}, 1, 10)); !errors.Is(err, ErrCycle) {
+		t.Errorf("want %v, got %v", ErrCycle, err)
+	}
+	if _, ok := example(t).Cycle(); ok {
+		t.Errorf("example: want no cycle")
+	}
+}
+
+func TestCountOverflow(t *testing.T) {
+	t.Parallel()
+	s := parse(t, "in{A}")
+	_, err := s.Count(Bounds([]string{"a", "b", "c", "d", "e", "f"}, 1, 4000))
+	if !errors.Is(err, mathx.ErrOverflow) {
+		t.Errorf("want %v, got %v", mathx.ErrOverflow, err)
+	}
+}
+
+func TestMissingBounds(t *testing.T) {
+	t.Parallel()
+	_, err := example(t).Count(Bounds([]string{"x", "m"}, 1, 4000))
+	if !errors.Is(err, ErrMissingRating) {
+		t.Errorf("want %v, got %v", ErrMissingRating, err)
+	}
+}
+
+func TestEliminate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s      *System
+		bounds Box
+		want   []string
+	}{
+		{
+			example(t), xmas(),
+			[]string{
+				"px{a<2006:qkq,m>2090:A,rfg}",
+				"pv{a>1716:R,A}",
+				"rfg{s<537:R,x>2440:R,A}",
+				"qkq{x<1416:A,crn}",
+				"crn{x>2662:A,R}",
+				"in{s<1351:px,qqz}",
+				"qqz{s>2770:A,m<1801:hdj,R}",
+				"hdj{m>838:A,pv}",
+			},
+		},
+		{
+			parse(t, "in{x<10:a,x<5:R,b}", "a{x>20:R,A}", "b{A}", "c{R}"),
+			Bounds([]string{"x"}, 1, 4000),
+			[]string{"in{A}"},
+		},
+		{
+			parse(t, "in{x<5:p,A}", "p{x>10:in,m<3:R,A}"),
+			Bounds([]string{"x", "m"}, 1, 10),
+			[]string{"in{x<5:p,A}", "p{m<3:R,A}"},
+		},
+	}
+
+	for _, test := range tests {
+		e, err := test.s.Eliminate(test.bounds)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := make([]string, len(e.Names))
+		for i, name := range e.Names {
+			got[i] = e.Workflows[name].String()
+		}
+		if !slices.Equal(test.want, got) {
+			t.Errorf("%v: want %v, got %v", test.s.Names, test.want, got)
+		}
+
+		before, err := test.s.Count(test.bounds)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if after, err := e.Count(test.bounds); after != before || err != nil {
+			t.Errorf("%v: want %d parts accepted, got %d, %v", test.s.Names, before, after, err)
+		}
+		for _, p := range test.s.Parts {
+			want, _ := test.s.Accepts(p)
+			if got, err := e.Accepts(p); got != want || err != nil {
+				t.Errorf("%v: want %v accepted: %v, got %v, %v", test.s.Names, p, want, got, err)
+			}
+		}
+	}
+}
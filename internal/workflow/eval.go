@@ -0,0 +1,55 @@
+package workflow
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Route sends p through the workflows from Start and returns the rules it
+// follows, the last of which sends it to Accept or Reject. It returns an
+// error wrapping ErrCycle when p comes back to a workflow, and one wrapping
+// ErrMissingRating when a condition asks for a rating p lacks.
+func (s *System) Route(p Part) ([]Ref, error) {
+	var result []Ref
+	seen := make(map[string]bool)
+	for name := Start; name != Accept && name != Reject; {
+		if seen[name] {
+			return nil, fmt.Errorf("%w: %v goes %s -> %s", ErrCycle, p, routeString(result), name)
+		}
+		seen[name] = true
+
+		for i, r := range s.Workflows[name].Rules {
+			if r.Condition != nil {
+				rating, ok := p[r.Condition.Category]
+				if !ok {
+					return nil, fmt.Errorf("%w %s of %v at %v", ErrMissingRating, r.Condition.Category, p, r.Pos)
+				}
+				if !r.Condition.Matches(rating) {
+					continue
+				}
+			}
+			result = append(result, Ref{name, i})
+			name = r.Target
+			break
+		}
+	}
+	return result, nil
+}
+
+// Accepts reports whether the workflows accept p, see Route.
+func (s *System) Accepts(p Part) (bool, error) {
+	route, err := s.Route(p)
+	if err != nil {
+		return false, err
+	}
+	return s.Rule(route[len(route)-1]).Target == Accept, nil
+}
+
+// routeString lists the workflows of a route.
+func routeString(route []Ref) string {
+	names := make([]string, len(route))
+	for i, r := range route {
+		names[i] = r.Workflow
+	}
+	return strings.Join(names, " -> ")
+}
@@ -0,0 +1,112 @@
+package workflow
+
+import (
+	"fmt"
+	"strconv"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenName
+	tokenNumber
+	tokenLess
+	tokenGreater
+	tokenColon
+	tokenComma
+	tokenEquals
+	tokenOpen
+	tokenClose
+)
+
+// symbols are the tokens of a single character.
+var symbols = map[byte]tokenKind{
+	'<': tokenLess,
+	'>': tokenGreater,
+	':': tokenColon,
+	',': tokenComma,
+	'=': tokenEquals,
+	'{': tokenOpen,
+	'}': tokenClose,
+}
+
+func (k tokenKind) String() string {
+	switch k {
+	case tokenEOF:
+		return "end of input"
+	case tokenName:
+		return "name"
+	case tokenNumber:
+		return "number"
+	}
+	for ch, kind := range symbols {
+		if kind == k {
+			return strconv.QuoteRune(rune(ch))
+		}
+	}
+	return fmt.Sprintf("token %d", int(k))
+}
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  Pos
+}
+
+func (t token) String() string {
+	if t.kind == tokenName || t.kind == tokenNumber {
+		return fmt.Sprintf("%v %q", t.kind, t.text)
+	}
+	return t.kind.String()
+}
+
+func isLetter(ch byte) bool {
+	return 'a' <= ch && ch <= 'z' || 'A' <= ch && ch <= 'Z' || ch == '_'
+}
+
+func isDigit(ch byte) bool {
+	return '0' <= ch && ch <= '9'
+}
+
+// lex splits lines into tokens, ending with a tokenEOF. Names start with a
+// letter and go on with letters and digits; white space, including line
+// breaks, separates tokens.
+func lex(lines []string) ([]token, error) {
+	result := make([]token, 0)
+	for l, line := range lines {
+		for c := 0; c < len(line); {
+			ch := line[c]
+			pos := Pos{l + 1, c + 1}
+			start := c
+			switch {
+			case ch == ' ' || ch == '\t' || ch == '\r':
+				c++
+				continue
+			case isLetter(ch):
+				for c < len(line) && (isLetter(line[c]) || isDigit(line[c])) {
+					c++
+				}
+				result = append(result, token{tokenName, line[start:c], pos})
+			case isDigit(ch):
+				for c < len(line) && isDigit(line[c]) {
+					c++
+				}
+				result = append(result, token{tokenNumber, line[start:c], pos})
+			default:
+				kind, ok := symbols[ch]
+				if !ok {
+					return nil, errorAt(pos, "unexpected %q", ch)
+				}
+				c++
+				result = append(result, token{kind, line[start:c], pos})
+			}
+		}
+	}
+
+	end := Pos{len(lines), 1}
+	if len(lines) > 0 {
+		end.Column = len(lines[len(lines)-1]) + 1
+	}
+	return append(result, token{kind: tokenEOF, pos: end}), nil
+}
@@ -0,0 +1,203 @@
+package workflow
+
+import (
+	"strconv"
+
+	"adventofcode23/internal/day"
+)
+
+// errorAt returns a *day.ParseError at pos.
+func errorAt(pos Pos, format string, a ...any) error {
+	return day.Locate(day.Errorf(pos.Column, format, a...), pos.Line)
+}
+
+type parser struct {
+	tokens []token
+	next   int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.next]
+}
+
+func (p *parser) take() token {
+	t := p.tokens[p.next]
+	if t.kind != tokenEOF {
+		p.next++
+	}
+	return t
+}
+
+func (p *parser) expect(kind tokenKind) (token, error) {
+	t := p.take()
+	if t.kind != kind {
+		return token{}, errorAt(t.pos, "want %v, got %v", kind, t)
+	}
+	return t, nil
+}
+
+func (p *parser) number() (int, error) {
+	t, err := p.expect(tokenNumber)
+	if err != nil {
+		return 0, err
+	}
+	n, err := strconv.Atoi(t.text)
+	if err != nil {
+		return 0, errorAt(t.pos, "invalid number %q", t.text)
+	}
+	return n, nil
+}
+
+// rule parses
+//
+//	rule = [ name ( "<" | ">" ) number ":" ] name
+func (p *parser) rule() (Rule, error) {
+	first, err := p.expect(tokenName)
+	if err != nil {
+		return Rule{}, err
+	}
+	result := Rule{Target: first.text, Pos: first.pos}
+
+	var op Op
+	switch p.peek().kind {
+	case tokenLess:
+		op = Less
+	case tokenGreater:
+		op = Greater
+	default:
+		return result, nil
+	}
+	p.take()
+	value, err := p.number()
+	if err != nil {
+		return Rule{}, err
+	}
+	if _, err := p.expect(tokenColon); err != nil {
+		return Rule{}, err
+	}
+	target, err := p.expect(tokenName)
+	if err != nil {
+		return Rule{}, err
+	}
+	result.Condition = &Condition{Category: first.text, Op: op, Value: value}
+	result.Target = target.text
+	return result, nil
+}
+
+// workflow parses
+//
+//	workflow = name "{" rule { "," rule } "}"
+func (p *parser) workflow() (*Workflow, error) {
+	name, err := p.expect(tokenName)
+	if err != nil {
+		return nil, err
+	}
+	if name.text == Accept || name.text == Reject {
+		return nil, errorAt(name.pos, "%s is not a workflow", name.text)
+	}
+	if _, err := p.expect(tokenOpen); err != nil {
+		return nil, err
+	}
+
+	result := &Workflow{Name: name.text, Pos: name.pos}
+	for {
+		r, err := p.rule()
+		if err != nil {
+			return nil, err
+		}
+		result.Rules = append(result.Rules, r)
+		t := p.take()
+		if t.kind == tokenClose {
+			if r.Condition != nil {
+				return nil, errorAt(t.pos, "workflow %s ends with a condition", result.Name)
+			}
+			return result, nil
+		}
+		if t.kind != tokenComma {
+			return nil, errorAt(t.pos, "want ',' or '}', got %v", t)
+		}
+	}
+}
+
+// part parses
+//
+//	part = "{" name "=" number { "," name "=" number } "}"
+func (p *parser) part() (Part, error) {
+	if _, err := p.expect(tokenOpen); err != nil {
+		return nil, err
+	}
+	result := make(Part)
+	for {
+		category, err := p.expect(tokenName)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := result[category.text]; ok {
+			return nil, errorAt(category.pos, "duplicate rating %s", category.text)
+		}
+		if _, err := p.expect(tokenEquals); err != nil {
+			return nil, err
+		}
+		if result[category.text], err = p.number(); err != nil {
+			return nil, err
+		}
+		t := p.take()
+		if t.kind == tokenClose {
+			return result, nil
+		}
+		if t.kind != tokenComma {
+			return nil, errorAt(t.pos, "want ',' or '}', got %v", t)
+		}
+	}
+}
+
+// Parse parses a system of workflows followed by parts:
+//
+//	system = { workflow } { part }
+//
+// Parts may be left out. Errors are *day.ParseError positioned at the
+// offending token. Parse checks that the workflows have distinct names, that
+// Start is among them and that rules only send parts to workflows or to
+// Accept and Reject.
+func Parse(lines []string) (*System, error) {
+	tokens, err := lex(lines)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	result := &System{Workflows: make(map[string]*Workflow)}
+
+	for p.peek().kind == tokenName {
+		w, err := p.workflow()
+		if err != nil {
+			return nil, err
+		}
+		if previous, ok := result.Workflows[w.Name]; ok {
+			return nil, errorAt(w.Pos, "workflow %s already defined at %v", w.Name, previous.Pos)
+		}
+		result.Workflows[w.Name] = w
+		result.Names = append(result.Names, w.Name)
+	}
+	for p.peek().kind == tokenOpen {
+		part, err := p.part()
+		if err != nil {
+			return nil, err
+		}
+		result.Parts = append(result.Parts, part)
+	}
+	if t := p.peek(); t.kind != tokenEOF {
+		return nil, errorAt(t.pos, "want workflow or part, got %v", t)
+	}
+
+	if _, ok := result.Workflows[Start]; !ok {
+		return nil, day.Locate(ErrNoStart, 0)
+	}
+	for _, name := range result.Names {
+		for _, r := range result.Workflows[name].Rules {
+			if _, ok := result.Workflows[r.Target]; !ok && r.Target != Accept && r.Target != Reject {
+				return nil, errorAt(r.Pos, "unknown workflow %q", r.Target)
+			}
+		}
+	}
+	return result, nil
+}
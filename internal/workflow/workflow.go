@@ -0,0 +1,185 @@
+// Package workflow implements the language of the workflows of day 19, in
+// which rules send parts on by comparing their ratings with thresholds:
+//
+//	px{a<2006:qkq,m>2090:A,rfg}
+//	{x=787,m=2655,a=1222,s=2876}
+//
+// Parts can have ratings in any categories. Besides evaluating parts, a
+// System can be analysed symbolically: the ratings within a Box are split up
+// at every condition, which finds the exact boxes of ratings that are
+// accepted, the rules no rating reaches, and the workflows they lead to.
+package workflow
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+var (
+	ErrCycle         = errors.New("workflows send parts in circles")
+	ErrNoStart       = errors.New("no workflow named in")
+	ErrMissingRating = errors.New("missing rating")
+)
+
+const (
+	// Start is the workflow every part starts in.
+	Start = "in"
+	// Accept and Reject are the targets that end the way of a part.
+	Accept = "A"
+	Reject = "R"
+)
+
+// Pos is the position of a token in the input, counting from 1.
+type Pos struct {
+	Line, Column int
+}
+
+func (p Pos) String() string {
+	return fmt.Sprintf("%d:%d", p.Line, p.Column)
+}
+
+// Op is the comparison of a condition.
+type Op byte
+
+const (
+	Less    Op = '<'
+	Greater Op = '>'
+)
+
+// Condition compares the rating of a part in Category with Value.
+type Condition struct {
+	Category string
+	Op       Op
+	Value    int
+}
+
+// Matches reports whether a rating satisfies c.
+func (c Condition) Matches(rating int) bool {
+	if c.Op == Less {
+		return rating < c.Value
+	}
+	return rating > c.Value
+}
+
+func (c Condition) String() string {
+	return fmt.Sprintf("%s%c%d", c.Category, c.Op, c.Value)
+}
+
+// Rule sends the parts matching Condition to Target. A rule without
+// condition sends all parts there.
+type Rule struct {
+	Condition *Condition
+	Target    string
+	Pos       Pos
+}
+
+func (r Rule) String() string {
+	if r.Condition == nil {
+		return r.Target
+	}
+	return r.Condition.String() + ":" + r.Target
+}
+
+// Workflow is a list of rules, tried in order. The last rule has no
+// condition, so that every part is sent on.
+type Workflow struct {
+	Name  string
+	Rules []Rule
+	Pos   Pos
+}
+
+func (w *Workflow) String() string {
+	rules := make([]string, len(w.Rules))
+	for i, r := range w.Rules {
+		rules[i] = r.String()
+	}
+	return w.Name + "{" + strings.Join(rules, ",") + "}"
+}
+
+// Part is a part by its ratings per category.
+type Part map[string]int
+
+// Sum returns the sum of the ratings of p.
+func (p Part) Sum() int {
+	result := 0
+	for _, v := range p {
+		result += v
+	}
+	return result
+}
+
+func (p Part) String() string {
+	ratings := make([]string, 0, len(p))
+	for _, category := range sortedKeys(p) {
+		ratings = append(ratings, fmt.Sprintf("%s=%d", category, p[category]))
+	}
+	return "{" + strings.Join(ratings, ",") + "}"
+}
+
+// System is a set of workflows and the parts to sort with them.
+type System struct {
+	Workflows map[string]*Workflow
+	// Names holds the names of the workflows in the order of the input.
+	Names []string
+	Parts []Part
+}
+
+// String returns s in the language it is parsed from.
+func (s *System) String() string {
+	var b strings.Builder
+	for _, name := range s.Names {
+		b.WriteString(s.Workflows[name].String())
+		b.WriteByte('\n')
+	}
+	b.WriteByte('\n')
+	for _, p := range s.Parts {
+		b.WriteString(p.String())
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// Categories returns the categories that conditions and parts refer to, in
+// order.
+func (s *System) Categories() []string {
+	seen := make(map[string]bool)
+	for _, w := range s.Workflows {
+		for _, r := range w.Rules {
+			if r.Condition != nil {
+				seen[r.Condition.Category] = true
+			}
+		}
+	}
+	for _, p := range s.Parts {
+		for category := range p {
+			seen[category] = true
+		}
+	}
+	return sortedKeys(seen)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	result := make([]string, 0, len(m))
+	for k := range m {
+		result = append(result, k)
+	}
+	slices.Sort(result)
+	return result
+}
+
+// Ref refers to a rule of a workflow.
+type Ref struct {
+	Workflow string
+	Rule     int
+}
+
+func (r Ref) String() string {
+	return fmt.Sprintf("%s#%d", r.Workflow, r.Rule+1)
+}
+
+// Rule returns the rule r refers to.
+func (s *System) Rule(r Ref) Rule {
+	return s.Workflows[r.Workflow].Rules[r.Rule]
+}
@@ -0,0 +1,144 @@
+package workflow
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"adventofcode23/internal/day"
+)
+
+// exampleWorkflows are the workflows of the example of day 19.
+var exampleWorkflows = []string{
+	"px{a<2006:qkq,m>2090:A,rfg}",
+	"pv{a>1716:R,A}",
+	"lnx{m>1548:A,A}",
+	"rfg{s<537:gd,x>2440:R,A}",
+	"qs{s>3448:A,lnx}",
+	"qkq{x<1416:A,crn}",
+	"crn{x>2662:A,R}",
+	"in{s<1351:px,qqz}",
+	"qqz{s>2770:qs,m<1801:hdj,R}",
+	"gd{a>3333:R,R}",
+	"hdj{m>838:A,pv}",
+}
+
+func parse(t *testing.T, lines ...string) *System {
+	t.Helper()
+	s, err := Parse(lines)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func example(t *testing.T) *System {
+	t.Helper()
+	return parse(t, append(slices.Clone(exampleWorkflows),
+		"",
+		"{x=787,m=2655,a=1222,s=2876}",
+		"{x=1679,m=44,a=2067,s=496}",
+		"{x=2036,m=264,a=79,s=2244}",
+		"{x=2461,m=1339,a=466,s=291}",
+		"{x=2127,m=1623,a=2188,s=1013}",
+	)...)
+}
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+	s := example(t)
+
+	want := strings.Join(exampleWorkflows, "\n") + "\n\n{a=1222,m=2655,s=2876,x=787}\n"
+	if got := s.String(); !strings.HasPrefix(got, want) {
+		t.Errorf("want\n%s\ngot\n%s", want, got)
+	}
+	if want, got := []string{"a", "m", "s", "x"}, s.Categories(); !slices.Equal(want, got) {
+		t.Errorf("categories: want %v, got %v", want, got)
+	}
+	if want, got := (Pos{4, 14}), s.Workflows["rfg"].Rules[1].Pos; want != got {
+		t.Errorf("position of x>2440:R: want %v, got %v", want, got)
+	}
+
+	// white space and line breaks do not matter
+	s = parse(t, "in { speed > 10 : A ,", "  R }", "{speed=11, weight=2}")
+	if want, got := "in{speed>10:A,R}\n\n{speed=11,weight=2}\n", s.String(); want != got {
+		t.Errorf("want %q, got %q", want, got)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		lines        []string
+		line, column int
+		want         string
+	}{
+		{[]string{"in{x<:A}"}, 1, 6, "want number, got ':'"},
+		{[]string{"in{x<5:A}"}, 1, 9, "ends with a condition"},
+		{[]string{"in{x=5:A}"}, 1, 5, "want ',' or '}', got '='"},
+		{[]string{"in{A}", "in{R}"}, 2, 1, "already defined at 1:1"},
+		{[]string{"in{x<5:foo,A}"}, 1, 4, `unknown workflow "foo"`},
+		{[]string{"in{A}", "{x=1,x=2}"}, 2, 6, "duplicate rating x"},
+		{[]string{"in{A} $"}, 1, 7, `unexpected '$'`},
+		{[]string{"in{A}", "{x=1", ""}, 3, 1, "want ',' or '}', got end of input"},
+		{[]string{"A{R}"}, 1, 1, "A is not a workflow"},
+		{[]string{"in{A}", "{x=1}", "px{A}"}, 3, 1, "want workflow or part, got name \"px\""},
+		{[]string{"px{A}"}, 0, 0, ErrNoStart.Error()},
+	}
+
+	for _, test := range tests {
+		_, err := Parse(test.lines)
+		var pe *day.ParseError
+		if !errors.As(err, &pe) {
+			t.Errorf("%q: want a parse error, got %v", test.lines, err)
+			continue
+		}
+		if pe.Line != test.line || pe.Column != test.column || !strings.Contains(pe.Error(), test.want) {
+			t.Errorf("%q: want %q at %d:%d, got %v", test.lines, test.want, test.line, test.column, pe)
+		}
+	}
+}
+
+func TestAccepts(t *testing.T) {
+	t.Parallel()
+	s := example(t)
+
+	sum := 0
+	for _, p := range s.Parts {
+		ok, err := s.Accepts(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ok {
+			sum += p.Sum()
+		}
+	}
+	if sum != 19114 {
+		t.Errorf("want a sum of 19114, got %d", sum)
+	}
+
+	route, err := s.Route(s.Parts[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []Ref{{"in", 1}, {"qqz", 0}, {"qs", 1}, {"lnx", 0}}; !slices.Equal(want, route) {
+		t.Errorf("want route %v, got %v", want, route)
+	}
+}
+
+func TestAcceptsErrors(t *testing.T) {
+	t.Parallel()
+	s := parse(t, "in{x<5:loop,A}", "loop{in}")
+
+	if _, err := s.Accepts(Part{"x": 1}); !errors.Is(err, ErrCycle) {
+		t.Errorf("want %v, got %v", ErrCycle, err)
+	}
+	if ok, err := s.Accepts(Part{"x": 5}); !ok || err != nil {
+		t.Errorf("want x=5 accepted, got %v, %v", ok, err)
+	}
+	if _, err := s.Accepts(Part{"m": 1}); !errors.Is(err, ErrMissingRating) {
+		t.Errorf("want %v, got %v", ErrMissingRating, err)
+	}
+}